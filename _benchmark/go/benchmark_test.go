@@ -7,7 +7,9 @@ import (
 
 	gomarkdown "github.com/gomarkdown/markdown"
 	"github.com/pgavlin/goldmark"
+	"github.com/pgavlin/goldmark/parser"
 	"github.com/pgavlin/goldmark/renderer/html"
+	"github.com/pgavlin/goldmark/text"
 	"github.com/pgavlin/goldmark/util"
 	"gitlab.com/golang-commonmark/markdown"
 
@@ -74,6 +76,39 @@ func BenchmarkMarkdown(b *testing.B) {
 
 }
 
+// BenchmarkParse compares goldmark's parser with and without a parser.Arena
+// and parallel inline parsing.
+func BenchmarkParse(b *testing.B) {
+	source, err := ioutil.ReadFile("_data.md")
+	if err != nil {
+		b.Fatal(err)
+	}
+	markdown := goldmark.New()
+
+	b.Run("Heap", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			markdown.Parser().Parse(text.NewReader(source))
+		}
+	})
+
+	b.Run("Arena", func(b *testing.B) {
+		arena := parser.NewArena()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			arena.Reset()
+			markdown.Parser().Parse(text.NewReader(source), parser.WithArena(arena))
+		}
+	})
+
+	b.Run("Parallel", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			markdown.Parser().Parse(text.NewReader(source), parser.WithParallelInlines(0))
+		}
+	})
+}
+
 // The different frameworks have different APIs. Create an adapter that
 // should behave the same in the memory department.
 func doBenchmark(b *testing.B, render func(src []byte) ([]byte, error)) {
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+)
+
+func emojiSubCommand(args []string) {
+	cmdName := "emoji"
+	cmd := flag.NewFlagSet(cmdName, flag.ExitOnError)
+	cmd.Usage = func() {
+		_, _ = fmt.Fprintf(cmd.Output(), `Usage of %s:
+
+  Generate input JSON data for emb-structs subcommand from the gemoji database
+
+`, cmdName)
+		cmd.PrintDefaults()
+	}
+
+	outputPath := cmd.String("o", "", "output file path(required)")
+	url := cmd.String("u", "https://raw.githubusercontent.com/github/gemoji/master/db/emoji.json", "gemoji database URL")
+
+	if err := cmd.Parse(args); err != nil ||
+		len(*outputPath) == 0 {
+		usage(cmd.Usage, err)
+	}
+
+	resp, err := http.Get(*url)
+	if err != nil {
+		fmt.Printf("Failed to get emoji.json: %v\n", err)
+		os.Exit(1)
+	}
+	defer resp.Body.Close()
+
+	bs, err := io.ReadAll(resp.Body)
+	if err != nil {
+		fmt.Printf("Failed to get emoji.json: %v\n", err)
+		os.Exit(1)
+	}
+
+	var list []struct {
+		Emoji   string   `json:"emoji"`
+		Aliases []string `json:"aliases"`
+	}
+	if err := json.Unmarshal(bs, &list); err != nil {
+		panic(err)
+	}
+
+	embstructmap := make(map[string]any)
+	embstructmap["prefix"] = "emoji"
+	embstructmap["types"] = map[string]any{
+		"Name":       "string",
+		"Characters": "[]byte",
+	}
+	var data []any
+
+	// Each alias of an emoji is a separate entry, so that aliases can be
+	// looked up like names.
+	for _, emoji := range list {
+		var characters []string
+		for _, c := range []byte(emoji.Emoji) {
+			characters = append(characters, fmt.Sprintf("0x%02x", c))
+		}
+		for _, alias := range emoji.Aliases {
+			if len(alias) == 0 {
+				continue
+			}
+			data = append(data, map[string]any{
+				"Name":       alias,
+				"Characters": characters,
+			})
+		}
+	}
+	embstructmap["data"] = data
+	jsonData, err := json.MarshalIndent(embstructmap, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	err = os.WriteFile(*outputPath, jsonData, 0644)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -23,6 +23,8 @@ func main() {
 		unicodeCaseFoldingMapSubCommand(args)
 	case "emb-structs":
 		embStructsSubCommand(args)
+	case "emoji":
+		emojiSubCommand(args)
 	case "-h":
 		fallthrough
 	default:
@@ -31,6 +33,7 @@ subcommands:
   oss-fuzz-corpus
   unicode-case-folding-map
   emb-structs
+  emoji
 `)
 		os.Exit(1)
 	}
@@ -508,6 +508,8 @@ const (
 type Walker func(n Node, entering bool) (WalkStatus, error)
 
 // Walk walks a AST tree by the depth first search algorithm.
+// If inline parsing of a block has been deferred, Walk parses the inline
+// children of the block before it walks them.
 func Walk(n Node, walker Walker) error {
 	_, err := walkHelper(n, walker)
 	return err
@@ -519,6 +521,9 @@ func walkHelper(n Node, walker Walker) (WalkStatus, error) {
 		return status, err
 	}
 	if status != WalkSkipChildren {
+		if n.Type() != TypeInline {
+			ParseDeferredInlines(n)
+		}
 		for c := n.FirstChild(); c != nil; c = c.NextSibling() {
 			if st, err := walkHelper(c, walker); err != nil || st == WalkStop {
 				return WalkStop, err
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync"
+	"sync/atomic"
 
 	textm "github.com/pgavlin/goldmark/text"
 )
@@ -13,6 +15,7 @@ type BaseBlock struct {
 	BaseNode
 	lines              textm.Segments
 	blankPreviousLines bool
+	inlinesDeferred    uint32
 	leadingWhitespace  textm.Segment
 }
 
@@ -36,6 +39,22 @@ func (b *BaseBlock) SetBlankPreviousLines(v bool) {
 	b.blankPreviousLines = v
 }
 
+// InlinesDeferred returns true if the inline children of this block have
+// not been parsed yet. See Document.SetInlineParser for details.
+func (b *BaseBlock) InlinesDeferred() bool {
+	return atomic.LoadUint32(&b.inlinesDeferred) != 0
+}
+
+// SetInlinesDeferred sets whether the inline children of this block have
+// not been parsed yet.
+func (b *BaseBlock) SetInlinesDeferred(v bool) {
+	var u uint32
+	if v {
+		u = 1
+	}
+	atomic.StoreUint32(&b.inlinesDeferred, u)
+}
+
 // LeadingWhitespace returns the leading whitespace for this block, if any.
 func (b *BaseBlock) LeadingWhitespace() textm.Segment {
 	return b.leadingWhitespace
@@ -60,7 +79,9 @@ func (b *BaseBlock) SetLines(v *textm.Segments) {
 type Document struct {
 	BaseBlock
 
-	meta map[string]interface{}
+	meta         map[string]interface{}
+	inlineParser func(block Node)
+	inlineMutex  sync.Mutex
 }
 
 // KindDocument is a NodeKind of the Document node.
@@ -112,6 +133,47 @@ func (n *Document) AddMeta(key string, value interface{}) {
 	n.meta[key] = value
 }
 
+// SetInlineParser sets a function that parses the deferred inline children
+// of blocks in this document. Walk calls this function for a block whose
+// InlinesDeferred returns true before it walks the children of the block.
+// Calls of the function are serialized, and InlinesDeferred of the block
+// returns false once the function returns.
+func (n *Document) SetInlineParser(f func(block Node)) {
+	n.inlineParser = f
+}
+
+// ParseDeferredInlines parses the inline children of the given block if
+// they have been deferred. It does nothing if the block is not a part of a
+// Document or inline parsing of the block has not been deferred.
+//
+// ParseDeferredInlines may be called concurrently, e.g. by concurrent calls
+// of Walk: the inline children of a block are parsed once, and are not
+// visible to other callers until they have been parsed.
+func ParseDeferredInlines(block Node) {
+	d, ok := block.(interface {
+		InlinesDeferred() bool
+		SetInlinesDeferred(bool)
+	})
+	if !ok || !d.InlinesDeferred() {
+		return
+	}
+	var doc *Document
+	if v, ok := block.(*Document); ok {
+		doc = v
+	} else if block.Parent() != nil {
+		doc = block.OwnerDocument()
+	}
+	if doc == nil || doc.inlineParser == nil {
+		return
+	}
+	doc.inlineMutex.Lock()
+	defer doc.inlineMutex.Unlock()
+	if d.InlinesDeferred() {
+		doc.inlineParser(block)
+		d.SetInlinesDeferred(false)
+	}
+}
+
 // NewDocument returns a new Document node.
 func NewDocument() *Document {
 	return &Document{
@@ -404,7 +466,7 @@ type List struct {
 	Marker byte
 
 	// IsTight is a true if this list is a 'tight' list.
-	// See https://spec.commonmark.org/0.30/#loose for details.
+	// See https://spec.commonmark.org/0.31.2/#loose for details.
 	IsTight bool
 
 	// Start is an initial number of this ordered list.
@@ -486,7 +548,7 @@ func NewListItem(offset int) *ListItem {
 }
 
 // HTMLBlockType represents kinds of an html blocks.
-// See https://spec.commonmark.org/0.30/#html-blocks
+// See https://spec.commonmark.org/0.31.2/#html-blocks
 type HTMLBlockType int
 
 const (
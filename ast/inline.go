@@ -122,7 +122,7 @@ func (n *Text) SetRaw(v bool) {
 }
 
 // HardLineBreak returns true if this node ends with a hard line break.
-// See https://spec.commonmark.org/0.30/#hard-line-breaks for details.
+// See https://spec.commonmark.org/0.31.2/#hard-line-breaks for details.
 func (n *Text) HardLineBreak() bool {
 	return n.flags&textHardLineBreak != 0
 }
@@ -419,6 +419,10 @@ type baseLink struct {
 
 	// Title is a title of this link.
 	Title []byte
+
+	// ExternalReference is true if the reference used by this link was not
+	// defined in the document but supplied by a parser.ReferenceResolver.
+	ExternalReference bool
 }
 
 // Inline implements Inline.Inline.
@@ -437,6 +441,9 @@ func (n *Link) Dump(w io.Writer, source []byte, level int) {
 	m["Label"] = string(n.Label)
 	m["Destination"] = string(n.Destination)
 	m["Title"] = string(n.Title)
+	if n.ExternalReference {
+		m["ExternalReference"] = "true"
+	}
 	DumpHelper(w, n, source, level, m, nil)
 }
 
@@ -470,6 +477,9 @@ func (n *Image) Dump(w io.Writer, source []byte, level int) {
 	m["Label"] = string(n.Label)
 	m["Destination"] = string(n.Destination)
 	m["Title"] = string(n.Title)
+	if n.ExternalReference {
+		m["ExternalReference"] = "true"
+	}
 	DumpHelper(w, n, source, level, m, nil)
 }
 
@@ -492,6 +502,7 @@ func NewImage(link *Link) *Image {
 	c.Label = link.Label
 	c.Destination = link.Destination
 	c.Title = link.Title
+	c.ExternalReference = link.ExternalReference
 	for n := link.FirstChild(); n != nil; {
 		next := n.NextSibling()
 		link.RemoveChild(link, n)
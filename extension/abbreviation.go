@@ -0,0 +1,307 @@
+package extension
+
+import (
+	"bytes"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/pgavlin/goldmark"
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/extension/ast"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/renderer"
+	"github.com/pgavlin/goldmark/renderer/html"
+	"github.com/pgavlin/goldmark/renderer/markdown"
+	"github.com/pgavlin/goldmark/text"
+	"github.com/pgavlin/goldmark/util"
+)
+
+var abbreviationListKey = parser.NewContextKey()
+
+type abbreviationParser struct {
+}
+
+var defaultAbbreviationParser = &abbreviationParser{}
+
+// NewAbbreviationParser returns a new parser.BlockParser that can parse
+// abbreviation definitions like '*[HTML]: Hyper Text Markup Language' of the
+// Markdown(PHP Markdown Extra) text.
+func NewAbbreviationParser() parser.BlockParser {
+	return defaultAbbreviationParser
+}
+
+func (b *abbreviationParser) Trigger() []byte {
+	return []byte{'*'}
+}
+
+func (b *abbreviationParser) Open(parent gast.Node, reader text.Reader, pc parser.Context) (gast.Node, parser.State) {
+	line, _ := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 || pos+1 >= len(line) || line[pos] != '*' || line[pos+1] != '[' {
+		return nil, parser.NoChildren
+	}
+	open := pos + 2
+	closes := bytes.IndexByte(line[open:], ']')
+	if closes < 0 {
+		return nil, parser.NoChildren
+	}
+	closes += open
+	if closes+1 >= len(line) || line[closes+1] != ':' {
+		return nil, parser.NoChildren
+	}
+	label := util.TrimRightSpace(util.TrimLeftSpace(line[open:closes]))
+	if len(label) == 0 || bytes.IndexByte(label, '[') >= 0 {
+		return nil, parser.NoChildren
+	}
+	expansion := util.TrimRightSpace(util.TrimLeftSpace(line[closes+2:]))
+	node := ast.NewAbbreviationDefinition(append([]byte{}, label...), append([]byte{}, expansion...))
+	reader.AdvanceToEOL()
+	return node, parser.NoChildren
+}
+
+func (b *abbreviationParser) Continue(node gast.Node, reader text.Reader, pc parser.Context) parser.State {
+	return parser.Close
+}
+
+func (b *abbreviationParser) Close(node gast.Node, reader text.Reader, pc parser.Context) {
+	definition := node.(*ast.AbbreviationDefinition)
+	var list []*ast.AbbreviationDefinition
+	if v := pc.Get(abbreviationListKey); v != nil {
+		list = v.([]*ast.AbbreviationDefinition)
+	}
+	// The first definition of an abbreviation wins, as with link reference
+	// definitions.
+	for _, d := range list {
+		if bytes.Equal(d.Label, definition.Label) {
+			return
+		}
+	}
+	pc.Set(abbreviationListKey, append(list, definition))
+}
+
+func (b *abbreviationParser) CanInterruptParagraph() bool {
+	return true
+}
+
+func (b *abbreviationParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+type abbreviationASTTransformer struct {
+}
+
+var defaultAbbreviationASTTransformer = &abbreviationASTTransformer{}
+
+// NewAbbreviationASTTransformer returns a new parser.ASTTransformer that
+// wraps whole-word occurrences of defined abbreviations in Text nodes with
+// Abbreviation nodes. Code spans, links, images and raw HTML are skipped.
+func NewAbbreviationASTTransformer() parser.ASTTransformer {
+	return defaultAbbreviationASTTransformer
+}
+
+func (a *abbreviationASTTransformer) CanTransformLazily() bool {
+	return true
+}
+
+func (a *abbreviationASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	v := pc.Get(abbreviationListKey)
+	if v == nil {
+		return
+	}
+	// Longer abbreviations take precedence over their prefixes.
+	definitions := append([]*ast.AbbreviationDefinition{}, v.([]*ast.AbbreviationDefinition)...)
+	sort.SliceStable(definitions, func(i, j int) bool {
+		return len(definitions[i].Label) > len(definitions[j].Label)
+	})
+
+	var texts []*gast.Text
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		switch n.Kind() {
+		case gast.KindCodeSpan, gast.KindLink, gast.KindImage, gast.KindAutoLink, gast.KindRawHTML,
+			ast.KindAbbreviation, ast.KindWikiLink, ast.KindMention, ast.KindCitationGroup:
+			return gast.WalkSkipChildren, nil
+		}
+		if t, ok := n.(*gast.Text); ok && !t.IsRaw() {
+			texts = append(texts, t)
+		}
+		return gast.WalkContinue, nil
+	})
+
+	source := reader.Source()
+	for _, t := range texts {
+		a.replace(t, definitions, source)
+	}
+}
+
+func (a *abbreviationASTTransformer) replace(t *gast.Text, definitions []*ast.AbbreviationDefinition, source []byte) {
+	parent := t.Parent()
+	stop := t.Segment.Stop
+	for i := t.Segment.Start; i < stop; i++ {
+		for _, d := range definitions {
+			end := i + len(d.Label)
+			if end > stop || !bytes.Equal(source[i:end], d.Label) || !isAbbreviationBoundary(source, i, end) {
+				continue
+			}
+			if i > t.Segment.Start {
+				parent.InsertBefore(parent, t, gast.NewTextSegment(t.Segment.WithStop(i)))
+			}
+			abbreviation := ast.NewAbbreviation(d.Expansion)
+			abbreviation.AppendChild(abbreviation, gast.NewTextSegment(text.NewSegment(i, end)))
+			parent.InsertBefore(parent, t, abbreviation)
+			t.Segment = t.Segment.WithStart(end)
+			i = end - 1
+			break
+		}
+	}
+	// The remaining text keeps line breaks that follow the original text.
+	if t.Segment.IsEmpty() && !t.SoftLineBreak() && !t.HardLineBreak() {
+		parent.RemoveChild(parent, t)
+	}
+}
+
+// isAbbreviationBoundary returns true if source[start:stop] is not a part
+// of a longer word.
+func isAbbreviationBoundary(source []byte, start, stop int) bool {
+	if r, _ := utf8.DecodeRune(source[start:]); isWordRune(r) && start > 0 {
+		if p, _ := utf8.DecodeLastRune(source[:start]); isWordRune(p) {
+			return false
+		}
+	}
+	if r, _ := utf8.DecodeLastRune(source[:stop]); isWordRune(r) && stop < len(source) {
+		if n, _ := utf8.DecodeRune(source[stop:]); isWordRune(n) {
+			return false
+		}
+	}
+	return true
+}
+
+// AbbreviationHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Abbreviation nodes as <abbr> elements. AbbreviationDefinition
+// nodes are not rendered.
+type AbbreviationHTMLRenderer struct {
+	html.Config
+}
+
+// NewAbbreviationHTMLRenderer returns a new AbbreviationHTMLRenderer.
+func NewAbbreviationHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	r := &AbbreviationHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *AbbreviationHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindAbbreviationDefinition, r.renderAbbreviationDefinition)
+	reg.Register(ast.KindAbbreviation, r.renderAbbreviation)
+}
+
+func (r *AbbreviationHTMLRenderer) renderAbbreviationDefinition(
+	w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	return gast.WalkSkipChildren, nil
+}
+
+func (r *AbbreviationHTMLRenderer) renderAbbreviation(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		_, _ = w.WriteString("</abbr>")
+		return gast.WalkContinue, nil
+	}
+	n := node.(*ast.Abbreviation)
+	_, _ = w.WriteString("<abbr")
+	if len(n.Expansion) != 0 {
+		_, _ = w.WriteString(` title="`)
+		_, _ = w.Write(util.EscapeHTML(n.Expansion))
+		_ = w.WriteByte('"')
+	}
+	_ = w.WriteByte('>')
+	return gast.WalkContinue, nil
+}
+
+// AbbreviationMarkdownRenderer is a renderer.NodeRenderer implementation
+// that writes Abbreviation and AbbreviationDefinition nodes back to Markdown
+// using a markdown.Renderer.
+type AbbreviationMarkdownRenderer struct {
+	*markdown.Renderer
+}
+
+// NewAbbreviationMarkdownRenderer returns a new AbbreviationMarkdownRenderer
+// that writes through the given markdown.Renderer.
+func NewAbbreviationMarkdownRenderer(r *markdown.Renderer) renderer.NodeRenderer {
+	return &AbbreviationMarkdownRenderer{r}
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *AbbreviationMarkdownRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindAbbreviationDefinition, r.renderAbbreviationDefinition)
+	reg.Register(ast.KindAbbreviation, r.renderAbbreviation)
+}
+
+func (r *AbbreviationMarkdownRenderer) renderAbbreviationDefinition(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		if err := r.CloseBlock(w); err != nil {
+			return gast.WalkStop, err
+		}
+		return gast.WalkContinue, nil
+	}
+	if err := r.OpenBlock(w, source, node); err != nil {
+		return gast.WalkStop, err
+	}
+	n := node.(*ast.AbbreviationDefinition)
+	if _, err := r.WriteString(w, "*["); err != nil {
+		return gast.WalkStop, err
+	}
+	if _, err := r.Write(w, n.Label); err != nil {
+		return gast.WalkStop, err
+	}
+	if _, err := r.WriteString(w, "]:"); err != nil {
+		return gast.WalkStop, err
+	}
+	if len(n.Expansion) != 0 {
+		if err := r.WriteByte(w, ' '); err != nil {
+			return gast.WalkStop, err
+		}
+		if _, err := r.Write(w, n.Expansion); err != nil {
+			return gast.WalkStop, err
+		}
+	}
+	if err := r.WriteByte(w, '\n'); err != nil {
+		return gast.WalkStop, err
+	}
+	return gast.WalkContinue, nil
+}
+
+func (r *AbbreviationMarkdownRenderer) renderAbbreviation(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	// Abbreviations are found in plain text, so writing the text child
+	// writes the abbreviation back as it was.
+	return gast.WalkContinue, nil
+}
+
+type abbreviations struct {
+}
+
+// Abbreviation is an extension that allows you to define abbreviations
+// like '*[HTML]: Hyper Text Markup Language'.
+var Abbreviation = &abbreviations{}
+
+func (e *abbreviations) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(
+			util.Prioritized(NewAbbreviationParser(), 999),
+		),
+		parser.WithASTTransformers(
+			util.Prioritized(NewAbbreviationASTTransformer(), 999),
+		),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewAbbreviationHTMLRenderer(), 500),
+	))
+}
@@ -0,0 +1,53 @@
+package extension
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pgavlin/goldmark"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/testutil"
+	"github.com/pgavlin/goldmark/text"
+)
+
+func TestAbbreviation(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			Abbreviation,
+		),
+	)
+	testutil.DoTestCaseFile(markdown, "_test/abbreviation.txt", t, testutil.ParseCliCaseArg()...)
+}
+
+func TestAbbreviationLazyInlines(t *testing.T) {
+	md := goldmark.New(goldmark.WithExtensions(Abbreviation))
+	source := []byte("- The W3C\n\n*[W3C]: World Wide Web Consortium\n")
+	doc := md.Parser().Parse(text.NewReader(source), parser.WithLazyInlines())
+	var buf bytes.Buffer
+	if err := md.Renderer().Render(&buf, source, doc); err != nil {
+		t.Fatal(err)
+	}
+	expected := "<ul>\n<li>The <abbr title=\"World Wide Web Consortium\">W3C</abbr></li>\n</ul>\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestAbbreviationInOtherExtensions(t *testing.T) {
+	md := goldmark.New(goldmark.WithExtensions(
+		Abbreviation,
+		WikiLink,
+		NewMention(WithMentionResolver(testMentionResolver)),
+		Citation,
+	))
+	source := []byte("W3C [[W3C]] #W3C [@W3C]\n\n*[W3C]: World Wide Web Consortium\n")
+	var buf bytes.Buffer
+	if err := md.Convert(source, &buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := "<p><abbr title=\"World Wide Web Consortium\">W3C</abbr> <a href=\"W3C\">W3C</a> " +
+		"<a href=\"/tags/W3C\">#W3C</a> <span class=\"citation\" data-cites=\"W3C\">(W3C?)</span></p>\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
@@ -0,0 +1,382 @@
+package extension
+
+import (
+	"bytes"
+	"regexp"
+
+	"github.com/pgavlin/goldmark"
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/extension/ast"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/renderer"
+	"github.com/pgavlin/goldmark/renderer/html"
+	"github.com/pgavlin/goldmark/renderer/markdown"
+	"github.com/pgavlin/goldmark/text"
+	"github.com/pgavlin/goldmark/util"
+)
+
+var alertMarkerRegexp = regexp.MustCompile(`^\[!([A-Za-z]+)\](?:[ \t]+(.*?))?[ \t]*\r?\n?$`)
+
+// gitHubAlertTypes is a set of alert types that GitHub recognizes.
+var gitHubAlertTypes = map[string]bool{
+	"note":      true,
+	"tip":       true,
+	"important": true,
+	"warning":   true,
+	"caution":   true,
+}
+
+type alertASTTransformer struct {
+}
+
+var defaultAlertASTTransformer = &alertASTTransformer{}
+
+// NewAlertASTTransformer returns a new parser.ASTTransformer that replaces
+// blockquotes that start with a marker like '[!NOTE]' with Alert nodes.
+// Text that follows the marker on the same line is the title of the alert.
+func NewAlertASTTransformer() parser.ASTTransformer {
+	return defaultAlertASTTransformer
+}
+
+func (a *alertASTTransformer) CanTransformLazily() bool {
+	return true
+}
+
+func (a *alertASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	var quotes []gast.Node
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		// Leaf blocks can not contain blockquotes, so we do not parse their
+		// deferred inlines.
+		if n.Type() == gast.TypeInline || n.Lines().Len() != 0 {
+			return gast.WalkSkipChildren, nil
+		}
+		if entering && n.Kind() == gast.KindBlockquote {
+			quotes = append(quotes, n)
+		}
+		return gast.WalkContinue, nil
+	})
+
+	for _, q := range quotes {
+		alert := newGitHubAlert(q, reader.Source())
+		if alert == nil {
+			continue
+		}
+		alert.SetBlankPreviousLines(q.HasBlankPreviousLines())
+		for c := q.FirstChild(); c != nil; {
+			next := c.NextSibling()
+			alert.AppendChild(alert, c)
+			c = next
+		}
+		q.Parent().ReplaceChild(q.Parent(), q, alert)
+	}
+}
+
+// newGitHubAlert returns a new Alert if the first line of the given
+// blockquote is an alert marker, and removes the marker from the blockquote.
+func newGitHubAlert(quote gast.Node, source []byte) *ast.Alert {
+	para, ok := quote.FirstChild().(*gast.Paragraph)
+	if !ok || para.Lines().Len() == 0 {
+		return nil
+	}
+	first := para.Lines().At(0)
+	m := alertMarkerRegexp.FindSubmatch(util.TrimLeftSpace(first.Value(source)))
+	if m == nil {
+		return nil
+	}
+	alertType := bytes.ToLower(m[1])
+	if !gitHubAlertTypes[string(alertType)] {
+		return nil
+	}
+	// GitHub does not render alerts without content.
+	if para.Lines().Len() == 1 && para.NextSibling() == nil {
+		return nil
+	}
+
+	var markers []gast.Node
+	if !para.InlinesDeferred() {
+		for c := para.FirstChild(); c != nil; c = c.NextSibling() {
+			start, stop, ok := inlineExtent(c)
+			if ok && start >= first.Stop {
+				break
+			}
+			if stop > first.Stop {
+				// An inline like emphasis continues to the next line.
+				return nil
+			}
+			markers = append(markers, c)
+		}
+	}
+
+	alert := ast.NewAlert(ast.AlertStyleGitHub, alertType)
+	if len(m[2]) != 0 {
+		alert.Title = append([]byte{}, m[2]...)
+	}
+	if para.Lines().Len() == 1 {
+		quote.RemoveChild(quote, para)
+		return alert
+	}
+	for _, c := range markers {
+		para.RemoveChild(para, c)
+	}
+	lines := para.Lines()
+	lines.SetSliced(1, lines.Len())
+	return alert
+}
+
+// inlineExtent returns the start and stop of the source of the given inline
+// node. ok is false if the node has no text.
+func inlineExtent(n gast.Node) (start, stop int, ok bool) {
+	_ = gast.Walk(n, func(c gast.Node, entering bool) (gast.WalkStatus, error) {
+		if t, isText := c.(*gast.Text); entering && isText {
+			if !ok || t.Segment.Start < start {
+				start = t.Segment.Start
+			}
+			if !ok || t.Segment.Stop > stop {
+				stop = t.Segment.Stop
+			}
+			ok = true
+		}
+		return gast.WalkContinue, nil
+	})
+	return start, stop, ok
+}
+
+var mkDocsAdmonitionRegexp = regexp.MustCompile(`^!!![ \t]+([A-Za-z][A-Za-z0-9_-]*)(?:[ \t]+"([^"]*)")?[ \t]*\r?\n?$`)
+
+type mkDocsAdmonitionParser struct {
+}
+
+var defaultMkDocsAdmonitionParser = &mkDocsAdmonitionParser{}
+
+// NewMkDocsAdmonitionParser returns a new BlockParser that parses MkDocs
+// style admonitions like '!!! note "Title"' into Alert nodes. The content of
+// an admonition is indented by 4 spaces.
+func NewMkDocsAdmonitionParser() parser.BlockParser {
+	return defaultMkDocsAdmonitionParser
+}
+
+func (b *mkDocsAdmonitionParser) Trigger() []byte {
+	return []byte{'!'}
+}
+
+func (b *mkDocsAdmonitionParser) Open(parent gast.Node, reader text.Reader, pc parser.Context) (gast.Node, parser.State) {
+	line, _ := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 || line[pos] != '!' {
+		return nil, parser.NoChildren
+	}
+	m := mkDocsAdmonitionRegexp.FindSubmatchIndex(line[pos:])
+	if m == nil {
+		return nil, parser.NoChildren
+	}
+	node := ast.NewAlert(ast.AlertStyleMkDocs, bytes.ToLower(line[pos+m[2]:pos+m[3]]))
+	if m[4] >= 0 {
+		node.Title = append([]byte{}, line[pos+m[4]:pos+m[5]]...)
+	}
+	reader.AdvanceToEOL()
+	return node, parser.HasChildren
+}
+
+func (b *mkDocsAdmonitionParser) Continue(node gast.Node, reader text.Reader, pc parser.Context) parser.State {
+	line, _ := reader.PeekLine()
+	if util.IsBlank(line) {
+		reader.AdvanceToEOL()
+		return parser.Continue | parser.HasChildren
+	}
+	indent, _ := util.IndentWidth(line, reader.LineOffset())
+	if indent < 4 {
+		return parser.Close
+	}
+	pos, padding := util.IndentPosition(line, reader.LineOffset(), 4)
+	reader.AdvanceAndSetPadding(pos, padding)
+	return parser.Continue | parser.HasChildren
+}
+
+func (b *mkDocsAdmonitionParser) Close(node gast.Node, reader text.Reader, pc parser.Context) {
+	// nothing to do
+}
+
+func (b *mkDocsAdmonitionParser) CanInterruptParagraph() bool {
+	return true
+}
+
+func (b *mkDocsAdmonitionParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+// AlertHTMLRenderer is a renderer.NodeRenderer implementation that renders
+// Alert nodes. GitHub style alerts are rendered like GitHub renders them,
+// without icons:
+//
+//	<div class="markdown-alert markdown-alert-note">
+//	<p class="markdown-alert-title">Note</p>
+//	...
+//	</div>
+//
+// MkDocs style admonitions are rendered like MkDocs renders them:
+//
+//	<div class="admonition note">
+//	<p class="admonition-title">Note</p>
+//	...
+//	</div>
+type AlertHTMLRenderer struct {
+	html.Config
+}
+
+// NewAlertHTMLRenderer returns a new AlertHTMLRenderer.
+func NewAlertHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	r := &AlertHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *AlertHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindAlert, r.renderAlert)
+}
+
+func (r *AlertHTMLRenderer) renderAlert(
+	w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		_, _ = w.WriteString("</div>\n")
+		return gast.WalkContinue, nil
+	}
+	alert := n.(*ast.Alert)
+	class, titleClass := "markdown-alert markdown-alert-", "markdown-alert-title"
+	if alert.Style == ast.AlertStyleMkDocs {
+		class, titleClass = "admonition ", "admonition-title"
+	}
+	_, _ = w.WriteString(`<div class="`)
+	_, _ = w.WriteString(class)
+	_, _ = w.Write(util.EscapeHTML(alert.AlertType))
+	_, _ = w.WriteString("\">\n")
+
+	title := alert.Title
+	if title == nil {
+		title = alert.DefaultTitle()
+	}
+	if len(title) != 0 {
+		_, _ = w.WriteString(`<p class="`)
+		_, _ = w.WriteString(titleClass)
+		_, _ = w.WriteString(`">`)
+		r.Writer.Write(w, title)
+		_, _ = w.WriteString("</p>\n")
+	}
+	return gast.WalkContinue, nil
+}
+
+// AlertMarkdownRenderer is a renderer.NodeRenderer implementation that
+// writes Alert nodes back to Markdown using a markdown.Renderer.
+type AlertMarkdownRenderer struct {
+	*markdown.Renderer
+}
+
+// NewAlertMarkdownRenderer returns a new AlertMarkdownRenderer that writes
+// through the given markdown.Renderer.
+func NewAlertMarkdownRenderer(r *markdown.Renderer) renderer.NodeRenderer {
+	return &AlertMarkdownRenderer{r}
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *AlertMarkdownRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindAlert, r.renderAlert)
+}
+
+func (r *AlertMarkdownRenderer) renderAlert(
+	w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		r.PopPrefix()
+		if err := r.CloseBlock(w); err != nil {
+			return gast.WalkStop, err
+		}
+		return gast.WalkContinue, nil
+	}
+
+	if err := r.OpenBlock(w, source, n); err != nil {
+		return gast.WalkStop, err
+	}
+
+	alert := n.(*ast.Alert)
+	var buf bytes.Buffer
+	if alert.Style == ast.AlertStyleMkDocs {
+		buf.WriteString("!!! ")
+		buf.Write(alert.AlertType)
+		if alert.Title != nil {
+			buf.WriteString(` "`)
+			buf.Write(alert.Title)
+			buf.WriteByte('"')
+		}
+	} else {
+		buf.WriteString("> [!")
+		buf.Write(bytes.ToUpper(alert.AlertType))
+		buf.WriteByte(']')
+		if len(alert.Title) != 0 {
+			buf.WriteByte(' ')
+			buf.Write(alert.Title)
+		}
+	}
+	buf.WriteByte('\n')
+	if _, err := r.Write(w, buf.Bytes()); err != nil {
+		return gast.WalkStop, err
+	}
+
+	if alert.Style == ast.AlertStyleMkDocs {
+		r.PushIndent(4)
+	} else {
+		r.PushPrefix("> ")
+	}
+	return gast.WalkContinue, nil
+}
+
+// AlertConfig struct holds options for the extension.
+type AlertConfig struct {
+	// MkDocs is true if MkDocs style admonitions are parsed as well.
+	MkDocs bool
+}
+
+// An AlertOption sets options for the Alert extension.
+type AlertOption func(*AlertConfig)
+
+// WithMkDocsAdmonitions is a functional option that enables MkDocs style
+// admonitions like '!!! note "Title"' followed by content indented by 4
+// spaces.
+func WithMkDocsAdmonitions() AlertOption {
+	return func(c *AlertConfig) {
+		c.MkDocs = true
+	}
+}
+
+type alert struct {
+	AlertConfig
+}
+
+// Alert is an extension that renders GitHub style alerts like '> [!NOTE]'
+// as callouts.
+var Alert = NewAlert()
+
+// NewAlert returns a new extension with the given options.
+func NewAlert(opts ...AlertOption) goldmark.Extender {
+	e := &alert{}
+	for _, opt := range opts {
+		opt(&e.AlertConfig)
+	}
+	return e
+}
+
+func (e *alert) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithASTTransformers(
+		util.Prioritized(NewAlertASTTransformer(), 100),
+	))
+	if e.MkDocs {
+		m.Parser().AddOptions(parser.WithBlockParsers(
+			util.Prioritized(NewMkDocsAdmonitionParser(), 750),
+		))
+	}
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewAlertHTMLRenderer(), 500),
+	))
+}
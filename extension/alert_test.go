@@ -0,0 +1,43 @@
+package extension
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pgavlin/goldmark"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/testutil"
+	"github.com/pgavlin/goldmark/text"
+)
+
+func TestAlert(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			Alert,
+		),
+	)
+	testutil.DoTestCaseFile(markdown, "_test/alert.txt", t, testutil.ParseCliCaseArg()...)
+}
+
+func TestMkDocsAdmonitions(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewAlert(WithMkDocsAdmonitions()),
+		),
+	)
+	testutil.DoTestCaseFile(markdown, "_test/alert_mkdocs.txt", t, testutil.ParseCliCaseArg()...)
+}
+
+func TestAlertLazyInlines(t *testing.T) {
+	md := goldmark.New(goldmark.WithExtensions(Alert))
+	source := []byte("> [!NOTE] Title\n> *Text*\n")
+	doc := md.Parser().Parse(text.NewReader(source), parser.WithLazyInlines())
+	var buf bytes.Buffer
+	if err := md.Renderer().Render(&buf, source, doc); err != nil {
+		t.Fatal(err)
+	}
+	expected := "<div class=\"markdown-alert markdown-alert-note\">\n<p class=\"markdown-alert-title\">Title</p>\n<p><em>Text</em></p>\n</div>\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
@@ -0,0 +1,77 @@
+package ast
+
+import (
+	"io"
+
+	gast "github.com/pgavlin/goldmark/ast"
+)
+
+// An AbbreviationDefinition struct represents a definition of an
+// abbreviation like '*[HTML]: Hyper Text Markup Language'.
+type AbbreviationDefinition struct {
+	gast.BaseBlock
+
+	// Label is the abbreviation, like 'HTML'.
+	Label []byte
+
+	// Expansion is the expansion of the abbreviation, like
+	// 'Hyper Text Markup Language'.
+	Expansion []byte
+}
+
+// Dump implements Node.Dump.
+func (n *AbbreviationDefinition) Dump(w io.Writer, source []byte, level int) {
+	m := map[string]string{
+		"Label":     string(n.Label),
+		"Expansion": string(n.Expansion),
+	}
+	gast.DumpHelper(w, n, source, level, m, nil)
+}
+
+// KindAbbreviationDefinition is a NodeKind of the AbbreviationDefinition node.
+var KindAbbreviationDefinition = gast.NewNodeKind("AbbreviationDefinition")
+
+// Kind implements Node.Kind.
+func (n *AbbreviationDefinition) Kind() gast.NodeKind {
+	return KindAbbreviationDefinition
+}
+
+// NewAbbreviationDefinition returns a new AbbreviationDefinition node.
+func NewAbbreviationDefinition(label, expansion []byte) *AbbreviationDefinition {
+	return &AbbreviationDefinition{
+		Label:     label,
+		Expansion: expansion,
+	}
+}
+
+// An Abbreviation struct represents an occurrence of an abbreviation.
+// Children of an Abbreviation are the text of the abbreviation.
+type Abbreviation struct {
+	gast.BaseInline
+
+	// Expansion is the expansion of the abbreviation.
+	Expansion []byte
+}
+
+// Dump implements Node.Dump.
+func (n *Abbreviation) Dump(w io.Writer, source []byte, level int) {
+	m := map[string]string{
+		"Expansion": string(n.Expansion),
+	}
+	gast.DumpHelper(w, n, source, level, m, nil)
+}
+
+// KindAbbreviation is a NodeKind of the Abbreviation node.
+var KindAbbreviation = gast.NewNodeKind("Abbreviation")
+
+// Kind implements Node.Kind.
+func (n *Abbreviation) Kind() gast.NodeKind {
+	return KindAbbreviation
+}
+
+// NewAbbreviation returns a new Abbreviation node.
+func NewAbbreviation(expansion []byte) *Abbreviation {
+	return &Abbreviation{
+		Expansion: expansion,
+	}
+}
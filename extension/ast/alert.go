@@ -0,0 +1,85 @@
+package ast
+
+import (
+	"io"
+
+	gast "github.com/pgavlin/goldmark/ast"
+)
+
+// An AlertStyle is a syntax of an Alert.
+type AlertStyle int
+
+const (
+	// AlertStyleGitHub is a blockquote with a marker like '> [!NOTE]' on its
+	// first line.
+	AlertStyleGitHub AlertStyle = iota
+
+	// AlertStyleMkDocs is an admonition like '!!! note "Title"' followed by
+	// indented content.
+	AlertStyleMkDocs
+)
+
+// String implements fmt.Stringer.
+func (s AlertStyle) String() string {
+	switch s {
+	case AlertStyleGitHub:
+		return "GitHub"
+	case AlertStyleMkDocs:
+		return "MkDocs"
+	}
+	return "Unknown"
+}
+
+// An Alert struct represents a callout like GitHub alerts and MkDocs
+// admonitions. Children of an Alert are its content.
+type Alert struct {
+	gast.BaseBlock
+
+	// Style is a syntax of this alert.
+	Style AlertStyle
+
+	// AlertType is a lower case type of this alert, like 'note' or 'warning'.
+	AlertType []byte
+
+	// Title is a custom title of this alert. Title is nil if this alert has
+	// no custom title, and empty if the title is explicitly empty.
+	Title []byte
+}
+
+// DefaultTitle returns a title that is used if this alert has no custom
+// title: its type with the first letter in upper case.
+func (n *Alert) DefaultTitle() []byte {
+	title := append([]byte{}, n.AlertType...)
+	if len(title) != 0 && title[0] >= 'a' && title[0] <= 'z' {
+		title[0] -= 'a' - 'A'
+	}
+	return title
+}
+
+// Dump implements Node.Dump.
+func (n *Alert) Dump(w io.Writer, source []byte, level int) {
+	m := map[string]string{
+		"Style":     n.Style.String(),
+		"AlertType": string(n.AlertType),
+	}
+	if n.Title != nil {
+		m["Title"] = string(n.Title)
+	}
+	gast.DumpHelper(w, n, source, level, m, nil)
+}
+
+// KindAlert is a NodeKind of the Alert node.
+var KindAlert = gast.NewNodeKind("Alert")
+
+// Kind implements Node.Kind.
+func (n *Alert) Kind() gast.NodeKind {
+	return KindAlert
+}
+
+// NewAlert returns a new Alert node.
+func NewAlert(style AlertStyle, alertType []byte) *Alert {
+	return &Alert{
+		Style:     style,
+		AlertType: alertType,
+	}
+}
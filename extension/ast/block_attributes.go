@@ -0,0 +1,32 @@
+package ast
+
+import (
+	"io"
+
+	gast "github.com/pgavlin/goldmark/ast"
+)
+
+// A BlockAttributes struct represents a line of attributes like
+// '{: #id .class}' that applies to an adjacent block. The parsed
+// attributes are stored as the attributes of this node.
+type BlockAttributes struct {
+	gast.BaseBlock
+}
+
+// Dump implements Node.Dump.
+func (n *BlockAttributes) Dump(w io.Writer, source []byte, level int) {
+	gast.DumpHelper(w, n, source, level, nil, nil)
+}
+
+// KindBlockAttributes is a NodeKind of the BlockAttributes node.
+var KindBlockAttributes = gast.NewNodeKind("BlockAttributes")
+
+// Kind implements Node.Kind.
+func (n *BlockAttributes) Kind() gast.NodeKind {
+	return KindBlockAttributes
+}
+
+// NewBlockAttributes returns a new BlockAttributes node.
+func NewBlockAttributes() *BlockAttributes {
+	return &BlockAttributes{}
+}
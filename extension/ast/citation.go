@@ -0,0 +1,150 @@
+package ast
+
+import (
+	"fmt"
+	"io"
+
+	gast "github.com/pgavlin/goldmark/ast"
+)
+
+// A CitationGroup struct represents a group of Pandoc style citations,
+// either bracketed like '[see @smith2020, p. 4; -@doe1999]' or written in
+// the text like '@smith2020'. Children of a CitationGroup are Citation nodes.
+type CitationGroup struct {
+	gast.BaseInline
+
+	// Bracketed is true if this group is enclosed in brackets. Citations
+	// that are not bracketed are author-in-text citations.
+	Bracketed bool
+
+	// Formatted is the text of this group formatted by a citation resolver.
+	Formatted []byte
+}
+
+// Dump implements Node.Dump.
+func (n *CitationGroup) Dump(w io.Writer, source []byte, level int) {
+	m := map[string]string{
+		"Bracketed": fmt.Sprint(n.Bracketed),
+		"Formatted": string(n.Formatted),
+	}
+	gast.DumpHelper(w, n, source, level, m, nil)
+}
+
+// KindCitationGroup is a NodeKind of the CitationGroup node.
+var KindCitationGroup = gast.NewNodeKind("CitationGroup")
+
+// Kind implements Node.Kind.
+func (n *CitationGroup) Kind() gast.NodeKind {
+	return KindCitationGroup
+}
+
+// NewCitationGroup returns a new CitationGroup node.
+func NewCitationGroup(bracketed bool) *CitationGroup {
+	return &CitationGroup{
+		Bracketed: bracketed,
+	}
+}
+
+// A Citation struct represents a citation of a bibliography entry.
+type Citation struct {
+	gast.BaseInline
+
+	// Key is a key of the cited entry, like 'smith2020'.
+	Key []byte
+
+	// Prefix is text before the key, like 'see'.
+	Prefix []byte
+
+	// Locator is text after the key, like 'p. 4'.
+	Locator []byte
+
+	// SuppressAuthor is true if the author should be omitted, like in
+	// '[-@smith2020]'.
+	SuppressAuthor bool
+}
+
+// Dump implements Node.Dump.
+func (n *Citation) Dump(w io.Writer, source []byte, level int) {
+	m := map[string]string{
+		"Key":            string(n.Key),
+		"Prefix":         string(n.Prefix),
+		"Locator":        string(n.Locator),
+		"SuppressAuthor": fmt.Sprint(n.SuppressAuthor),
+	}
+	gast.DumpHelper(w, n, source, level, m, nil)
+}
+
+// KindCitation is a NodeKind of the Citation node.
+var KindCitation = gast.NewNodeKind("Citation")
+
+// Kind implements Node.Kind.
+func (n *Citation) Kind() gast.NodeKind {
+	return KindCitation
+}
+
+// NewCitation returns a new Citation node.
+func NewCitation(key []byte) *Citation {
+	return &Citation{
+		Key: key,
+	}
+}
+
+// A Bibliography struct represents a list of the cited bibliography
+// entries. Children of a Bibliography are BibliographyEntry nodes.
+type Bibliography struct {
+	gast.BaseBlock
+}
+
+// Dump implements Node.Dump.
+func (n *Bibliography) Dump(w io.Writer, source []byte, level int) {
+	gast.DumpHelper(w, n, source, level, nil, nil)
+}
+
+// KindBibliography is a NodeKind of the Bibliography node.
+var KindBibliography = gast.NewNodeKind("Bibliography")
+
+// Kind implements Node.Kind.
+func (n *Bibliography) Kind() gast.NodeKind {
+	return KindBibliography
+}
+
+// NewBibliography returns a new Bibliography node.
+func NewBibliography() *Bibliography {
+	return &Bibliography{}
+}
+
+// A BibliographyEntry struct represents an entry of a Bibliography.
+type BibliographyEntry struct {
+	gast.BaseBlock
+
+	// Key is a key of this entry.
+	Key []byte
+
+	// Formatted is the text of this entry formatted by a citation resolver.
+	Formatted []byte
+}
+
+// Dump implements Node.Dump.
+func (n *BibliographyEntry) Dump(w io.Writer, source []byte, level int) {
+	m := map[string]string{
+		"Key":       string(n.Key),
+		"Formatted": string(n.Formatted),
+	}
+	gast.DumpHelper(w, n, source, level, m, nil)
+}
+
+// KindBibliographyEntry is a NodeKind of the BibliographyEntry node.
+var KindBibliographyEntry = gast.NewNodeKind("BibliographyEntry")
+
+// Kind implements Node.Kind.
+func (n *BibliographyEntry) Kind() gast.NodeKind {
+	return KindBibliographyEntry
+}
+
+// NewBibliographyEntry returns a new BibliographyEntry node.
+func NewBibliographyEntry(key, formatted []byte) *BibliographyEntry {
+	return &BibliographyEntry{
+		Key:       key,
+		Formatted: formatted,
+	}
+}
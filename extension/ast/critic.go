@@ -0,0 +1,112 @@
+package ast
+
+import (
+	"io"
+
+	gast "github.com/pgavlin/goldmark/ast"
+)
+
+// A CriticMarkupType is a type of a CriticMarkup or a CriticMarkupBlock.
+type CriticMarkupType int
+
+const (
+	// CriticAddition is an addition like '{++added++}'.
+	CriticAddition CriticMarkupType = iota
+
+	// CriticDeletion is a deletion like '{--deleted--}'.
+	CriticDeletion
+
+	// CriticSubstitution is a substitution like '{~~old~>new~~}'.
+	CriticSubstitution
+
+	// CriticHighlight is a highlight like '{==highlighted==}'.
+	CriticHighlight
+
+	// CriticComment is a comment like '{>>comment<<}'.
+	CriticComment
+)
+
+// String implements fmt.Stringer.
+func (t CriticMarkupType) String() string {
+	switch t {
+	case CriticAddition:
+		return "Addition"
+	case CriticDeletion:
+		return "Deletion"
+	case CriticSubstitution:
+		return "Substitution"
+	case CriticHighlight:
+		return "Highlight"
+	case CriticComment:
+		return "Comment"
+	}
+	return "Unknown"
+}
+
+// A CriticMarkup struct represents an inline CriticMarkup change like
+// '{++added++}'. Children of a CriticMarkup are the contents of the change,
+// except for substitutions: children of a substitution are a CriticDeletion
+// of the old text followed by a CriticAddition of the new text.
+type CriticMarkup struct {
+	gast.BaseInline
+
+	// CriticMarkupType is a type of this change.
+	CriticMarkupType CriticMarkupType
+}
+
+// Dump implements Node.Dump.
+func (n *CriticMarkup) Dump(w io.Writer, source []byte, level int) {
+	m := map[string]string{
+		"CriticMarkupType": n.CriticMarkupType.String(),
+	}
+	gast.DumpHelper(w, n, source, level, m, nil)
+}
+
+// KindCriticMarkup is a NodeKind of the CriticMarkup node.
+var KindCriticMarkup = gast.NewNodeKind("CriticMarkup")
+
+// Kind implements Node.Kind.
+func (n *CriticMarkup) Kind() gast.NodeKind {
+	return KindCriticMarkup
+}
+
+// NewCriticMarkup returns a new CriticMarkup node.
+func NewCriticMarkup(typ CriticMarkupType) *CriticMarkup {
+	return &CriticMarkup{
+		CriticMarkupType: typ,
+	}
+}
+
+// A CriticMarkupBlock struct represents a CriticMarkup change of whole
+// blocks, which starts with a line like '{++' and ends with a line like
+// '++}'. Children of a CriticMarkupBlock are the changed blocks.
+type CriticMarkupBlock struct {
+	gast.BaseBlock
+
+	// CriticMarkupType is a type of this change. Substitutions can not
+	// change whole blocks.
+	CriticMarkupType CriticMarkupType
+}
+
+// Dump implements Node.Dump.
+func (n *CriticMarkupBlock) Dump(w io.Writer, source []byte, level int) {
+	m := map[string]string{
+		"CriticMarkupType": n.CriticMarkupType.String(),
+	}
+	gast.DumpHelper(w, n, source, level, m, nil)
+}
+
+// KindCriticMarkupBlock is a NodeKind of the CriticMarkupBlock node.
+var KindCriticMarkupBlock = gast.NewNodeKind("CriticMarkupBlock")
+
+// Kind implements Node.Kind.
+func (n *CriticMarkupBlock) Kind() gast.NodeKind {
+	return KindCriticMarkupBlock
+}
+
+// NewCriticMarkupBlock returns a new CriticMarkupBlock node.
+func NewCriticMarkupBlock(typ CriticMarkupType) *CriticMarkupBlock {
+	return &CriticMarkupBlock{
+		CriticMarkupType: typ,
+	}
+}
@@ -0,0 +1,125 @@
+package ast
+
+import (
+	"fmt"
+	"io"
+
+	gast "github.com/pgavlin/goldmark/ast"
+)
+
+// A DirectiveType is a type of a Directive.
+type DirectiveType int
+
+const (
+	// DirectiveInline is an inline directive like ':name[label]{attributes}'.
+	DirectiveInline DirectiveType = iota
+
+	// DirectiveLeaf is a leaf block directive like '::name[label]{attributes}'.
+	DirectiveLeaf
+
+	// DirectiveContainer is a container block directive that starts with a
+	// line like ':::name[label]{attributes}' and ends with a line like ':::'.
+	DirectiveContainer
+)
+
+// String implements fmt.Stringer.
+func (t DirectiveType) String() string {
+	switch t {
+	case DirectiveInline:
+		return "Inline"
+	case DirectiveLeaf:
+		return "Leaf"
+	case DirectiveContainer:
+		return "Container"
+	}
+	return "Unknown"
+}
+
+// A Directive struct represents a generic directive as proposed in
+// https://talk.commonmark.org/t/generic-directives-plugins-syntax/444.
+//
+// Children of inline and leaf directives are the inline contents of their
+// labels. Children of container directives are blocks; if a container
+// directive has a label, its first child is a DirectiveLabel.
+//
+// Inline directives are inline nodes: Type returns ast.TypeInline for them.
+// Attributes of a directive are attributes of the node.
+type Directive struct {
+	gast.BaseBlock
+
+	// DirectiveType is a type of this directive.
+	DirectiveType DirectiveType
+
+	// Name is a name of this directive.
+	Name []byte
+
+	// HasLabel is true if this inline or leaf directive has a label, even
+	// if the label is empty.
+	HasLabel bool
+
+	// Fence is a number of colons of the opening line of this container
+	// directive.
+	Fence int
+}
+
+// Type implements Node.Type.
+func (n *Directive) Type() gast.NodeType {
+	if n.DirectiveType == DirectiveInline {
+		return gast.TypeInline
+	}
+	return gast.TypeBlock
+}
+
+// Dump implements Node.Dump.
+func (n *Directive) Dump(w io.Writer, source []byte, level int) {
+	m := map[string]string{
+		"DirectiveType": n.DirectiveType.String(),
+		"Name":          string(n.Name),
+	}
+	if n.DirectiveType == DirectiveContainer {
+		m["Fence"] = fmt.Sprint(n.Fence)
+	} else {
+		m["HasLabel"] = fmt.Sprint(n.HasLabel)
+	}
+	gast.DumpHelper(w, n, source, level, m, nil)
+}
+
+// KindDirective is a NodeKind of the Directive node.
+var KindDirective = gast.NewNodeKind("Directive")
+
+// Kind implements Node.Kind.
+func (n *Directive) Kind() gast.NodeKind {
+	return KindDirective
+}
+
+// NewDirective returns a new Directive node.
+func NewDirective(typ DirectiveType, name []byte) *Directive {
+	return &Directive{
+		DirectiveType: typ,
+		Name:          name,
+	}
+}
+
+// A DirectiveLabel struct represents a label of a container directive.
+// Children of a DirectiveLabel are the inline contents of the label.
+type DirectiveLabel struct {
+	gast.BaseBlock
+}
+
+// Dump implements Node.Dump.
+func (n *DirectiveLabel) Dump(w io.Writer, source []byte, level int) {
+	gast.DumpHelper(w, n, source, level, nil, nil)
+}
+
+// KindDirectiveLabel is a NodeKind of the DirectiveLabel node.
+var KindDirectiveLabel = gast.NewNodeKind("DirectiveLabel")
+
+// Kind implements Node.Kind.
+func (n *DirectiveLabel) Kind() gast.NodeKind {
+	return KindDirectiveLabel
+}
+
+// NewDirectiveLabel returns a new DirectiveLabel node.
+func NewDirectiveLabel() *DirectiveLabel {
+	return &DirectiveLabel{}
+}
@@ -0,0 +1,43 @@
+package ast
+
+import (
+	"io"
+
+	gast "github.com/pgavlin/goldmark/ast"
+)
+
+// An Emoji struct represents an emoji shortcode like ':smile:'.
+type Emoji struct {
+	gast.BaseInline
+
+	// ShortCode is a shortcode of this emoji without colons, like 'smile'.
+	ShortCode []byte
+
+	// Characters is a UTF-8 encoded Unicode sequence of this emoji.
+	Characters []byte
+}
+
+// Dump implements Node.Dump.
+func (n *Emoji) Dump(w io.Writer, source []byte, level int) {
+	m := map[string]string{
+		"ShortCode":  string(n.ShortCode),
+		"Characters": string(n.Characters),
+	}
+	gast.DumpHelper(w, n, source, level, m, nil)
+}
+
+// KindEmoji is a NodeKind of the Emoji node.
+var KindEmoji = gast.NewNodeKind("Emoji")
+
+// Kind implements Node.Kind.
+func (n *Emoji) Kind() gast.NodeKind {
+	return KindEmoji
+}
+
+// NewEmoji returns a new Emoji node.
+func NewEmoji(shortCode, characters []byte) *Emoji {
+	return &Emoji{
+		ShortCode:  shortCode,
+		Characters: characters,
+	}
+}
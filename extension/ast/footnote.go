@@ -14,6 +14,13 @@ type FootnoteLink struct {
 	Index    int
 	RefCount int
 	RefIndex int
+
+	// Ref is the label of the footnote, or nil if the footnote is an
+	// inline footnote.
+	Ref []byte
+	// ID is the unique id of the footnote that is generated from its
+	// label by the footnote AST transformer.
+	ID []byte
 }
 
 // Dump implements Node.Dump.
@@ -22,6 +29,8 @@ func (n *FootnoteLink) Dump(w io.Writer, source []byte, level int) {
 	m["Index"] = fmt.Sprintf("%v", n.Index)
 	m["RefCount"] = fmt.Sprintf("%v", n.RefCount)
 	m["RefIndex"] = fmt.Sprintf("%v", n.RefIndex)
+	m["Ref"] = string(n.Ref)
+	m["ID"] = string(n.ID)
 	gast.DumpHelper(w, n, source, level, m, nil)
 }
 
@@ -49,6 +58,13 @@ type FootnoteBacklink struct {
 	Index    int
 	RefCount int
 	RefIndex int
+
+	// Ref is the label of the footnote, or nil if the footnote is an
+	// inline footnote.
+	Ref []byte
+	// ID is the unique id of the footnote that is generated from its
+	// label by the footnote AST transformer.
+	ID []byte
 }
 
 // Dump implements Node.Dump.
@@ -57,6 +73,8 @@ func (n *FootnoteBacklink) Dump(w io.Writer, source []byte, level int) {
 	m["Index"] = fmt.Sprintf("%v", n.Index)
 	m["RefCount"] = fmt.Sprintf("%v", n.RefCount)
 	m["RefIndex"] = fmt.Sprintf("%v", n.RefIndex)
+	m["Ref"] = string(n.Ref)
+	m["ID"] = string(n.ID)
 	gast.DumpHelper(w, n, source, level, m, nil)
 }
 
@@ -78,11 +96,15 @@ func NewFootnoteBacklink(index int) *FootnoteBacklink {
 }
 
 // A Footnote struct represents a footnote of Markdown
-// (PHP Markdown Extra) text.
+// (PHP Markdown Extra) text. Inline footnotes like '^[a note]' are
+// represented as Footnote nodes with a nil Ref.
 type Footnote struct {
 	gast.BaseBlock
 	Ref   []byte
 	Index int
+	// ID is the unique id of the footnote that is generated from its
+	// label by the footnote AST transformer.
+	ID []byte
 }
 
 // Dump implements Node.Dump.
@@ -90,6 +112,7 @@ func (n *Footnote) Dump(w io.Writer, source []byte, level int) {
 	m := map[string]string{}
 	m["Index"] = fmt.Sprintf("%v", n.Index)
 	m["Ref"] = string(n.Ref)
+	m["ID"] = string(n.ID)
 	gast.DumpHelper(w, n, source, level, m, nil)
 }
 
@@ -0,0 +1,52 @@
+package ast
+
+import (
+	"io"
+
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/text"
+)
+
+// A FrontMatter struct represents a metadata block at the start of a document,
+// like YAML front matter. Lines of the node are the contents of the block
+// without delimiters.
+type FrontMatter struct {
+	gast.BaseBlock
+
+	// Format is a name of the format of this front matter, like "yaml".
+	Format string
+
+	// Opening is a segment of the line that opens this front matter.
+	Opening text.Segment
+
+	// Closing is a segment of the line that closes this front matter.
+	Closing text.Segment
+}
+
+// Dump implements Node.Dump.
+func (n *FrontMatter) Dump(w io.Writer, source []byte, level int) {
+	m := map[string]string{
+		"Format": n.Format,
+	}
+	gast.DumpHelper(w, n, source, level, m, nil)
+}
+
+// KindFrontMatter is a NodeKind of the FrontMatter node.
+var KindFrontMatter = gast.NewNodeKind("FrontMatter")
+
+// Kind implements Node.Kind.
+func (n *FrontMatter) Kind() gast.NodeKind {
+	return KindFrontMatter
+}
+
+// IsRaw implements Node.IsRaw.
+func (n *FrontMatter) IsRaw() bool {
+	return true
+}
+
+// NewFrontMatter returns a new FrontMatter node.
+func NewFrontMatter(format string) *FrontMatter {
+	return &FrontMatter{
+		Format: format,
+	}
+}
@@ -0,0 +1,39 @@
+package ast
+
+import (
+	"io"
+
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/text"
+)
+
+// An InlineAttributes struct represents attributes like '{.class}' that
+// follow an inline element. The parsed attributes are stored as the
+// attributes of this node. InlineAttributes nodes are removed once the
+// attributes have been moved to the preceding element.
+type InlineAttributes struct {
+	gast.BaseInline
+
+	// Segment is a position of the attributes in the source.
+	Segment text.Segment
+}
+
+// Dump implements Node.Dump.
+func (n *InlineAttributes) Dump(w io.Writer, source []byte, level int) {
+	gast.DumpHelper(w, n, source, level, nil, nil)
+}
+
+// KindInlineAttributes is a NodeKind of the InlineAttributes node.
+var KindInlineAttributes = gast.NewNodeKind("InlineAttributes")
+
+// Kind implements Node.Kind.
+func (n *InlineAttributes) Kind() gast.NodeKind {
+	return KindInlineAttributes
+}
+
+// NewInlineAttributes returns a new InlineAttributes node.
+func NewInlineAttributes(segment text.Segment) *InlineAttributes {
+	return &InlineAttributes{
+		Segment: segment,
+	}
+}
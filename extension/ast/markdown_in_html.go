@@ -0,0 +1,66 @@
+package ast
+
+import (
+	"fmt"
+	"io"
+
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/text"
+)
+
+// An HTMLElement struct represents an HTML element whose contents are
+// Markdown text, like '<div markdown="1">'. Children of an HTMLElement are
+// blocks, or inlines if IsSpan is true.
+type HTMLElement struct {
+	gast.BaseBlock
+
+	// Tag is a lowercase tag name of this element, like "div".
+	Tag []byte
+
+	// IsSpan is true if the contents of this element are inlines.
+	IsSpan bool
+
+	// OpeningTag is a segment of the opening tag of this element.
+	OpeningTag text.Segment
+
+	// MarkdownAttribute is a segment of the attribute in the opening tag
+	// that marks the contents as Markdown, including leading spaces.
+	MarkdownAttribute text.Segment
+
+	// ClosingTag is a segment of the closing tag of this element.
+	ClosingTag text.Segment
+}
+
+// HasClosingTag returns true if this element has a closing tag,
+// otherwise false.
+func (n *HTMLElement) HasClosingTag() bool {
+	return n.ClosingTag.Start >= 0
+}
+
+// Dump implements Node.Dump.
+func (n *HTMLElement) Dump(w io.Writer, source []byte, level int) {
+	m := map[string]string{
+		"Tag":    string(n.Tag),
+		"IsSpan": fmt.Sprintf("%v", n.IsSpan),
+	}
+	gast.DumpHelper(w, n, source, level, m, nil)
+}
+
+// KindHTMLElement is a NodeKind of the HTMLElement node.
+var KindHTMLElement = gast.NewNodeKind("HTMLElement")
+
+// Kind implements Node.Kind.
+func (n *HTMLElement) Kind() gast.NodeKind {
+	return KindHTMLElement
+}
+
+// NewHTMLElement returns a new HTMLElement node.
+func NewHTMLElement(tag []byte, isSpan bool) *HTMLElement {
+	return &HTMLElement{
+		Tag:               tag,
+		IsSpan:            isSpan,
+		OpeningTag:        text.NewSegment(-1, -1),
+		MarkdownAttribute: text.NewSegment(-1, -1),
+		ClosingTag:        text.NewSegment(-1, -1),
+	}
+}
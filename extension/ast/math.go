@@ -0,0 +1,84 @@
+package ast
+
+import (
+	"fmt"
+	"io"
+
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/text"
+)
+
+// An InlineMath struct represents an inline math expression like '$x_1$'.
+// Children of an InlineMath are raw Text nodes.
+type InlineMath struct {
+	gast.BaseInline
+
+	// Display is true if the expression is surrounded by '$$' and should be
+	// displayed in its own block, like '$$x_1$$'.
+	Display bool
+}
+
+// Dump implements Node.Dump.
+func (n *InlineMath) Dump(w io.Writer, source []byte, level int) {
+	m := map[string]string{
+		"Display": fmt.Sprintf("%v", n.Display),
+	}
+	gast.DumpHelper(w, n, source, level, m, nil)
+}
+
+// KindInlineMath is a NodeKind of the InlineMath node.
+var KindInlineMath = gast.NewNodeKind("InlineMath")
+
+// Kind implements Node.Kind.
+func (n *InlineMath) Kind() gast.NodeKind {
+	return KindInlineMath
+}
+
+// NewInlineMath returns a new InlineMath node.
+func NewInlineMath() *InlineMath {
+	return &InlineMath{}
+}
+
+// A DisplayMath struct represents a block of math like '$$' fences or
+// fenced code blocks with the 'math' info string. Lines of the node are the
+// contents of the block without delimiters.
+type DisplayMath struct {
+	gast.BaseBlock
+
+	// Opening is a segment of the line that opens this block.
+	Opening text.Segment
+
+	// Closing is a segment of the line that closes this block.
+	Closing text.Segment
+}
+
+// HasClosing returns true if this block has a closing line, otherwise false.
+func (n *DisplayMath) HasClosing() bool {
+	return n.Closing.Start >= 0
+}
+
+// IsRaw implements Node.IsRaw.
+func (n *DisplayMath) IsRaw() bool {
+	return true
+}
+
+// Dump implements Node.Dump.
+func (n *DisplayMath) Dump(w io.Writer, source []byte, level int) {
+	gast.DumpHelper(w, n, source, level, nil, nil)
+}
+
+// KindDisplayMath is a NodeKind of the DisplayMath node.
+var KindDisplayMath = gast.NewNodeKind("DisplayMath")
+
+// Kind implements Node.Kind.
+func (n *DisplayMath) Kind() gast.NodeKind {
+	return KindDisplayMath
+}
+
+// NewDisplayMath returns a new DisplayMath node.
+func NewDisplayMath(opening text.Segment) *DisplayMath {
+	return &DisplayMath{
+		Opening: opening,
+		Closing: text.NewSegment(-1, -1),
+	}
+}
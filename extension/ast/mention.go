@@ -0,0 +1,94 @@
+package ast
+
+import (
+	"fmt"
+	"io"
+
+	gast "github.com/pgavlin/goldmark/ast"
+)
+
+// A MentionType is a type of a Mention.
+type MentionType int
+
+const (
+	// MentionUser is a mention of a user like '@user'.
+	MentionUser MentionType = iota
+
+	// MentionIssue is a reference to an issue like '#123', 'org/repo#45'
+	// or 'PROJ-123'.
+	MentionIssue
+
+	// MentionHashtag is a hashtag like '#tag'.
+	MentionHashtag
+)
+
+// String implements fmt.Stringer.
+func (t MentionType) String() string {
+	switch t {
+	case MentionUser:
+		return "User"
+	case MentionIssue:
+		return "Issue"
+	case MentionHashtag:
+		return "Hashtag"
+	}
+	return "Unknown"
+}
+
+// A Mention struct represents a short reference to a user, an issue or a
+// tag, like '@user', 'org/repo#45' or '#tag'. Children of a Mention are
+// the text of the mention.
+type Mention struct {
+	gast.BaseInline
+
+	// MentionType is a type of this mention.
+	MentionType MentionType
+
+	// Repository is a repository or a project of this mention, like
+	// 'org/repo' in 'org/repo#45'. Repository is nil if the mention has no
+	// repository.
+	Repository []byte
+
+	// ID is a name of the user, the issue or the tag, like '45' in
+	// 'org/repo#45'.
+	ID []byte
+
+	// Destination is a destination of this mention.
+	Destination []byte
+
+	// Resolved is true if this mention was resolved to a destination.
+	Resolved bool
+}
+
+// Dump implements Node.Dump.
+func (n *Mention) Dump(w io.Writer, source []byte, level int) {
+	m := map[string]string{
+		"MentionType": n.MentionType.String(),
+		"ID":          string(n.ID),
+		"Resolved":    fmt.Sprint(n.Resolved),
+	}
+	if n.Repository != nil {
+		m["Repository"] = string(n.Repository)
+	}
+	if n.Resolved {
+		m["Destination"] = string(n.Destination)
+	}
+	gast.DumpHelper(w, n, source, level, m, nil)
+}
+
+// KindMention is a NodeKind of the Mention node.
+var KindMention = gast.NewNodeKind("Mention")
+
+// Kind implements Node.Kind.
+func (n *Mention) Kind() gast.NodeKind {
+	return KindMention
+}
+
+// NewMention returns a new Mention node.
+func NewMention(typ MentionType, repository, id []byte) *Mention {
+	return &Mention{
+		MentionType: typ,
+		Repository:  repository,
+		ID:          id,
+	}
+}
@@ -0,0 +1,65 @@
+package ast
+
+import (
+	"fmt"
+	"io"
+
+	gast "github.com/pgavlin/goldmark/ast"
+)
+
+// A WikiLink struct represents a wiki link like '[[Page#Section|label]]', or
+// an embed like '![[image.png]]'. Children of a WikiLink are the text that
+// is displayed: its label if any, otherwise its target and fragment.
+type WikiLink struct {
+	gast.BaseInline
+
+	// Target is a name of the linked page, like 'Page'. Target is empty if
+	// the link refers to a section of the current page.
+	Target []byte
+
+	// Fragment is a section of the linked page, like 'Section'.
+	Fragment []byte
+
+	// Label is the label of this link, or nil if this link has no label.
+	Label []byte
+
+	// Embed is true if this is an embed like '![[image.png]]'.
+	Embed bool
+
+	// Destination is a destination of the linked page, without the fragment.
+	Destination []byte
+
+	// Exists is true if the linked page exists.
+	Exists bool
+}
+
+// Dump implements Node.Dump.
+func (n *WikiLink) Dump(w io.Writer, source []byte, level int) {
+	m := map[string]string{
+		"Target":      string(n.Target),
+		"Fragment":    string(n.Fragment),
+		"Embed":       fmt.Sprint(n.Embed),
+		"Destination": string(n.Destination),
+		"Exists":      fmt.Sprint(n.Exists),
+	}
+	if n.Label != nil {
+		m["Label"] = string(n.Label)
+	}
+	gast.DumpHelper(w, n, source, level, m, nil)
+}
+
+// KindWikiLink is a NodeKind of the WikiLink node.
+var KindWikiLink = gast.NewNodeKind("WikiLink")
+
+// Kind implements Node.Kind.
+func (n *WikiLink) Kind() gast.NodeKind {
+	return KindWikiLink
+}
+
+// NewWikiLink returns a new WikiLink node.
+func NewWikiLink(target, fragment []byte) *WikiLink {
+	return &WikiLink{
+		Target:   target,
+		Fragment: fragment,
+	}
+}
@@ -121,3 +121,17 @@ func TestASTInlineNodeText(t *testing.T) {
 	}
 
 }
+
+func TestConvertInline(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(GFM, Typographer),
+	)
+	var b bytes.Buffer
+	if err := goldmark.ConvertInlineWith(markdown, []byte(`# ~~"old"~~ see https://example.com`), &b); err != nil {
+		t.Fatal(err)
+	}
+	expected := `# <del>&ldquo;old&rdquo;</del> see <a href="https://example.com">https://example.com</a>`
+	if b.String() != expected {
+		t.Errorf("expected %q, got %q", expected, b.String())
+	}
+}
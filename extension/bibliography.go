@@ -0,0 +1,561 @@
+package extension
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pgavlin/goldmark/extension/ast"
+)
+
+// A BibliographyName struct is a name of an author of a BibliographyItem.
+// Names of organizations have only a family name.
+type BibliographyName struct {
+	Family string
+	Given  string
+}
+
+// A BibliographyItem struct is an entry of a Bibliography.
+type BibliographyItem struct {
+	// Key is a key that citations use to refer to this item.
+	Key string
+
+	// Type is a type of this item, like 'book' or 'article-journal'.
+	Type string
+
+	// Authors are authors of this item.
+	Authors []BibliographyName
+
+	// Title is a title of this item.
+	Title string
+
+	// ContainerTitle is a title of a journal or a book that contains this
+	// item.
+	ContainerTitle string
+
+	// Publisher is a publisher of this item.
+	Publisher string
+
+	// Year is a year in which this item was issued.
+	Year string
+
+	// URL is a URL of this item.
+	URL string
+}
+
+// A Bibliography is a CitationResolver that formats citations in an
+// author-date style, like '(Smith 2020, p. 4)' and 'Smith (2020)'. The
+// bibliography is sorted by authors, years and titles.
+type Bibliography struct {
+	items map[string]*BibliographyItem
+}
+
+// NewBibliography returns a new Bibliography with the given items.
+func NewBibliography(items ...*BibliographyItem) *Bibliography {
+	b := &Bibliography{
+		items: map[string]*BibliographyItem{},
+	}
+	for _, item := range items {
+		b.Add(item)
+	}
+	return b
+}
+
+// LoadBibliography reads a bibliography from the named file of the given
+// file system. Files with the '.bib' extension are read as BibTeX, and files
+// with the '.json' extension are read as CSL-JSON.
+func LoadBibliography(fsys fs.FS, name string) (*Bibliography, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	switch ext := strings.ToLower(path.Ext(name)); ext {
+	case ".bib":
+		return ParseBibTeX(data)
+	case ".json":
+		return ParseCSLJSON(data)
+	default:
+		return nil, fmt.Errorf("unsupported bibliography format %q", ext)
+	}
+}
+
+// Add adds the given item to this bibliography. An item with the same key
+// is replaced.
+func (b *Bibliography) Add(item *BibliographyItem) {
+	b.items[item.Key] = item
+}
+
+// Lookup returns the item with the given key.
+func (b *Bibliography) Lookup(key string) (*BibliographyItem, bool) {
+	item, ok := b.items[key]
+	return item, ok
+}
+
+// HasEntry implements CitationResolver.HasEntry.
+func (b *Bibliography) HasEntry(key []byte) bool {
+	_, ok := b.items[string(key)]
+	return ok
+}
+
+// FormatCitation implements CitationResolver.FormatCitation.
+func (b *Bibliography) FormatCitation(group *ast.CitationGroup) []byte {
+	var buf bytes.Buffer
+	if group.Bracketed {
+		buf.WriteByte('(')
+	}
+	for c := group.FirstChild(); c != nil; c = c.NextSibling() {
+		citation := c.(*ast.Citation)
+		if c != group.FirstChild() {
+			buf.WriteString("; ")
+		}
+		if len(citation.Prefix) != 0 {
+			buf.Write(citation.Prefix)
+			buf.WriteByte(' ')
+		}
+		item, ok := b.items[string(citation.Key)]
+		switch {
+		case !ok:
+			buf.Write(citation.Key)
+			buf.WriteByte('?')
+		case !group.Bracketed:
+			if !citation.SuppressAuthor {
+				buf.WriteString(item.shortAuthors())
+				buf.WriteByte(' ')
+			}
+			buf.WriteByte('(')
+			buf.WriteString(item.year())
+		default:
+			if !citation.SuppressAuthor {
+				buf.WriteString(item.shortAuthors())
+				buf.WriteByte(' ')
+			}
+			buf.WriteString(item.year())
+		}
+		if len(citation.Locator) != 0 {
+			buf.WriteString(", ")
+			buf.Write(citation.Locator)
+		}
+		if ok && !group.Bracketed {
+			buf.WriteByte(')')
+		}
+	}
+	if group.Bracketed {
+		buf.WriteByte(')')
+	}
+	return buf.Bytes()
+}
+
+// FormatBibliography implements CitationResolver.FormatBibliography.
+func (b *Bibliography) FormatBibliography(keys [][]byte) []*ast.BibliographyEntry {
+	var items []*BibliographyItem
+	for _, key := range keys {
+		if item, ok := b.items[string(key)]; ok {
+			items = append(items, item)
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if a, b := items[i].sortAuthors(), items[j].sortAuthors(); a != b {
+			return a < b
+		}
+		if a, b := items[i].year(), items[j].year(); a != b {
+			return a < b
+		}
+		return items[i].Title < items[j].Title
+	})
+
+	entries := make([]*ast.BibliographyEntry, len(items))
+	for i, item := range items {
+		entries[i] = ast.NewBibliographyEntry([]byte(item.Key), []byte(item.format()))
+	}
+	return entries
+}
+
+func (item *BibliographyItem) year() string {
+	if item.Year == "" {
+		return "n.d."
+	}
+	return item.Year
+}
+
+// shortAuthors returns authors for in-text citations, like 'Smith',
+// 'Smith and Doe' or 'Smith et al.'.
+func (item *BibliographyItem) shortAuthors() string {
+	switch len(item.Authors) {
+	case 0:
+		if item.Title != "" {
+			return item.Title
+		}
+		return item.Key
+	case 1:
+		return item.Authors[0].Family
+	case 2:
+		return item.Authors[0].Family + " and " + item.Authors[1].Family
+	default:
+		return item.Authors[0].Family + " et al."
+	}
+}
+
+func (item *BibliographyItem) sortAuthors() string {
+	var names []string
+	for _, name := range item.Authors {
+		names = append(names, name.Family+", "+name.Given)
+	}
+	if len(names) == 0 {
+		return item.Title
+	}
+	return strings.ToLower(strings.Join(names, "; "))
+}
+
+// format returns a bibliography entry like 'Smith, John, and Jane Doe. 2020.
+// Title. Journal. Publisher.'.
+func (item *BibliographyItem) format() string {
+	var authors []string
+	for i, name := range item.Authors {
+		switch {
+		case name.Given == "":
+			authors = append(authors, name.Family)
+		case i == 0:
+			authors = append(authors, name.Family+", "+name.Given)
+		default:
+			authors = append(authors, name.Given+" "+name.Family)
+		}
+	}
+	var parts []string
+	switch len(authors) {
+	case 0:
+	case 1:
+		parts = append(parts, authors[0])
+	case 2:
+		parts = append(parts, authors[0]+", and "+authors[1])
+	default:
+		parts = append(parts, strings.Join(authors[:len(authors)-1], ", ")+", and "+authors[len(authors)-1])
+	}
+	parts = append(parts, item.year(), item.Title, item.ContainerTitle, item.Publisher, item.URL)
+
+	var buf strings.Builder
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		if buf.Len() != 0 {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(part)
+		if c := part[len(part)-1]; c != '.' && c != '?' && c != '!' && part != item.URL {
+			buf.WriteByte('.')
+		}
+	}
+	return buf.String()
+}
+
+type cslName struct {
+	Family  string `json:"family"`
+	Given   string `json:"given"`
+	Literal string `json:"literal"`
+}
+
+type cslDate struct {
+	DateParts [][]interface{} `json:"date-parts"`
+	Literal   string          `json:"literal"`
+	Raw       string          `json:"raw"`
+}
+
+type cslItem struct {
+	ID             interface{} `json:"id"`
+	Type           string      `json:"type"`
+	Author         []cslName   `json:"author"`
+	Editor         []cslName   `json:"editor"`
+	Title          string      `json:"title"`
+	ContainerTitle string      `json:"container-title"`
+	Publisher      string      `json:"publisher"`
+	Issued         *cslDate    `json:"issued"`
+	URL            string      `json:"URL"`
+}
+
+// ParseCSLJSON parses a bibliography in the CSL-JSON format.
+func ParseCSLJSON(data []byte) (*Bibliography, error) {
+	var items []cslItem
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("csl-json: %w", err)
+	}
+	b := NewBibliography()
+	for _, i := range items {
+		if i.ID == nil {
+			return nil, fmt.Errorf("csl-json: item without an id")
+		}
+		item := &BibliographyItem{
+			Key:            fmt.Sprint(i.ID),
+			Type:           i.Type,
+			Title:          i.Title,
+			ContainerTitle: i.ContainerTitle,
+			Publisher:      i.Publisher,
+			URL:            i.URL,
+		}
+		names := i.Author
+		if len(names) == 0 {
+			names = i.Editor
+		}
+		for _, name := range names {
+			if name.Literal != "" {
+				item.Authors = append(item.Authors, BibliographyName{Family: name.Literal})
+			} else {
+				item.Authors = append(item.Authors, BibliographyName{Family: name.Family, Given: name.Given})
+			}
+		}
+		if d := i.Issued; d != nil {
+			switch {
+			case len(d.DateParts) != 0 && len(d.DateParts[0]) != 0:
+				item.Year = fmt.Sprint(d.DateParts[0][0])
+			case d.Literal != "":
+				item.Year = d.Literal
+			case len(d.Raw) >= 4:
+				item.Year = d.Raw[:4]
+			}
+		}
+		b.Add(item)
+	}
+	return b, nil
+}
+
+// ParseBibTeX parses a bibliography in the BibTeX format. @string macros
+// are not expanded, and only common LaTeX escapes are replaced.
+func ParseBibTeX(data []byte) (*Bibliography, error) {
+	p := &bibtexParser{data: data}
+	b := NewBibliography()
+	for {
+		i := bytes.IndexByte(p.data[p.pos:], '@')
+		if i < 0 {
+			return b, nil
+		}
+		p.pos += i + 1
+		typ := strings.ToLower(p.identifier())
+		p.skipSpace()
+		if p.pos >= len(p.data) || (p.data[p.pos] != '{' && p.data[p.pos] != '(') {
+			continue
+		}
+		closer := byte('}')
+		if p.data[p.pos] == '(' {
+			closer = ')'
+		}
+		p.pos++
+		if typ == "comment" || typ == "preamble" || typ == "string" {
+			p.skipBalanced(closer)
+			continue
+		}
+		item, err := p.entry(typ, closer)
+		if err != nil {
+			return nil, err
+		}
+		b.Add(item)
+	}
+}
+
+type bibtexParser struct {
+	data []byte
+	pos  int
+}
+
+func (p *bibtexParser) skipSpace() {
+	for p.pos < len(p.data) && isBibTeXSpace(p.data[p.pos]) {
+		p.pos++
+	}
+}
+
+func isBibTeXSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func (p *bibtexParser) identifier() string {
+	start := p.pos
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		if isBibTeXSpace(c) || bytes.IndexByte([]byte(`{}()=,#"@`), c) >= 0 {
+			break
+		}
+		p.pos++
+	}
+	return string(p.data[start:p.pos])
+}
+
+func (p *bibtexParser) skipBalanced(closer byte) {
+	depth := 0
+	for ; p.pos < len(p.data); p.pos++ {
+		switch c := p.data[p.pos]; {
+		case c == '{':
+			depth++
+		case c == '}' && depth > 0:
+			depth--
+		case c == closer && depth == 0:
+			p.pos++
+			return
+		}
+	}
+}
+
+func (p *bibtexParser) entry(typ string, closer byte) (*BibliographyItem, error) {
+	p.skipSpace()
+	key := p.identifier()
+	if key == "" {
+		return nil, fmt.Errorf("bibtex: @%s entry without a key", typ)
+	}
+	fields := map[string]string{}
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.data) {
+			return nil, fmt.Errorf("bibtex: unterminated entry %q", key)
+		}
+		switch p.data[p.pos] {
+		case closer:
+			p.pos++
+			return newBibTeXItem(key, typ, fields), nil
+		case ',':
+			p.pos++
+			continue
+		}
+		name := strings.ToLower(p.identifier())
+		p.skipSpace()
+		if name == "" || p.pos >= len(p.data) || p.data[p.pos] != '=' {
+			return nil, fmt.Errorf("bibtex: invalid field in entry %q", key)
+		}
+		p.pos++
+		value, err := p.value()
+		if err != nil {
+			return nil, fmt.Errorf("bibtex: field %q of entry %q: %w", name, key, err)
+		}
+		fields[name] = value
+	}
+}
+
+// value parses a field value, which may be a concatenation of braced and
+// quoted strings, numbers and macro names.
+func (p *bibtexParser) value() (string, error) {
+	var buf strings.Builder
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.data) {
+			return "", fmt.Errorf("missing value")
+		}
+		switch p.data[p.pos] {
+		case '{', '"':
+			opener := p.data[p.pos]
+			start, depth := p.pos+1, 0
+			for p.pos++; ; p.pos++ {
+				if p.pos >= len(p.data) {
+					return "", fmt.Errorf("unterminated value")
+				}
+				c := p.data[p.pos]
+				if c == '{' {
+					depth++
+				} else if c == '}' && depth > 0 {
+					depth--
+				} else if depth == 0 && ((opener == '{' && c == '}') || (opener == '"' && c == '"')) {
+					break
+				}
+			}
+			buf.Write(p.data[start:p.pos])
+			p.pos++
+		default:
+			v := p.identifier()
+			if v == "" {
+				return "", fmt.Errorf("invalid value")
+			}
+			buf.WriteString(v)
+		}
+		p.skipSpace()
+		if p.pos >= len(p.data) || p.data[p.pos] != '#' {
+			return buf.String(), nil
+		}
+		p.pos++
+	}
+}
+
+func newBibTeXItem(key, typ string, fields map[string]string) *BibliographyItem {
+	item := &BibliographyItem{
+		Key:       key,
+		Type:      typ,
+		Title:     cleanBibTeX(fields["title"]),
+		Publisher: cleanBibTeX(fields["publisher"]),
+		Year:      cleanBibTeX(fields["year"]),
+		URL:       fields["url"],
+	}
+	if item.Year == "" && len(fields["date"]) >= 4 {
+		item.Year = fields["date"][:4]
+	}
+	for _, name := range []string{"journal", "journaltitle", "booktitle"} {
+		if v, ok := fields[name]; ok {
+			item.ContainerTitle = cleanBibTeX(v)
+			break
+		}
+	}
+	if item.Publisher == "" {
+		item.Publisher = cleanBibTeX(fields["institution"])
+	}
+	names := fields["author"]
+	if names == "" {
+		names = fields["editor"]
+	}
+	if names != "" {
+		for _, name := range splitBibTeX(names, " and ") {
+			item.Authors = append(item.Authors, parseBibTeXName(name))
+		}
+	}
+	return item
+}
+
+// splitBibTeX splits s around sep outside braces, ignoring the case of sep.
+// sep must be ASCII, so that it can be compared with s byte by byte.
+func splitBibTeX(s, sep string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		default:
+			if depth == 0 && i+len(sep) <= len(s) && strings.EqualFold(s[i:i+len(sep)], sep) {
+				parts = append(parts, s[start:i])
+				start = i + len(sep)
+				i = start - 1
+			}
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// parseBibTeXName parses a name like 'Smith, John', 'John Smith' or
+// '{World Health Organization}'.
+func parseBibTeXName(name string) BibliographyName {
+	name = strings.Join(strings.Fields(name), " ")
+	if parts := splitBibTeX(name, ","); len(parts) > 1 {
+		return BibliographyName{
+			Family: cleanBibTeX(parts[0]),
+			Given:  cleanBibTeX(parts[len(parts)-1]),
+		}
+	}
+	words := splitBibTeX(name, " ")
+	if len(words) == 1 {
+		return BibliographyName{Family: cleanBibTeX(words[0])}
+	}
+	return BibliographyName{
+		Family: cleanBibTeX(words[len(words)-1]),
+		Given:  cleanBibTeX(strings.Join(words[:len(words)-1], " ")),
+	}
+}
+
+var bibtexReplacer = strings.NewReplacer(
+	"{", "", "}", "",
+	`\&`, "&", `\%`, "%", `\$`, "$", `\_`, "_", `\#`, "#",
+	"~", " ", "---", "—", "--", "–",
+)
+
+// cleanBibTeX removes braces and common LaTeX escapes from s, and collapses
+// white spaces.
+func cleanBibTeX(s string) string {
+	return strings.Join(strings.Fields(bibtexReplacer.Replace(s)), " ")
+}
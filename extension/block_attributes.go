@@ -0,0 +1,206 @@
+package extension
+
+import (
+	"bytes"
+
+	"github.com/pgavlin/goldmark"
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/extension/ast"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/text"
+	"github.com/pgavlin/goldmark/util"
+)
+
+var attrNameClass = []byte("class")
+
+type blockAttributesParser struct {
+}
+
+var defaultBlockAttributesParser = &blockAttributesParser{}
+
+// NewBlockAttributesParser returns a new BlockParser that parses
+// attribute lines like '{: #id .class key="value"}' and '{#id .class}'.
+func NewBlockAttributesParser() parser.BlockParser {
+	return defaultBlockAttributesParser
+}
+
+func (b *blockAttributesParser) Trigger() []byte {
+	return []byte{'{'}
+}
+
+func (b *blockAttributesParser) Open(parent gast.Node, reader text.Reader, pc parser.Context) (gast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 || line[pos] != '{' {
+		return nil, parser.NoChildren
+	}
+	value := line[pos:]
+	if len(value) > 1 && value[1] == ':' { // kramdown style '{: ...}'
+		value = append([]byte{'{'}, value[2:]...)
+	}
+	lr := text.NewReader(value)
+	attrs, ok := parser.ParseAttributes(lr)
+	if !ok || len(attrs) == 0 {
+		return nil, parser.NoChildren
+	}
+	if rest, _ := lr.PeekLine(); !util.IsBlank(rest) {
+		return nil, parser.NoChildren
+	}
+
+	node := ast.NewBlockAttributes()
+	for _, attr := range attrs {
+		node.SetAttribute(attr.Name, attr.Value)
+	}
+	attrSegment := segment.WithStart(segment.Start + pos)
+	node.Lines().Append(attrSegment.TrimRightSpace(reader.Source()))
+	reader.Advance(segment.Len() - 1)
+	return node, parser.NoChildren
+}
+
+func (b *blockAttributesParser) Continue(node gast.Node, reader text.Reader, pc parser.Context) parser.State {
+	return parser.Close
+}
+
+func (b *blockAttributesParser) Close(node gast.Node, reader text.Reader, pc parser.Context) {
+	// nothing to do
+}
+
+func (b *blockAttributesParser) CanInterruptParagraph() bool {
+	return true
+}
+
+func (b *blockAttributesParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+type blockAttributesASTTransformer struct {
+}
+
+var defaultBlockAttributesASTTransformer = &blockAttributesASTTransformer{}
+
+// NewBlockAttributesASTTransformer returns a new parser.ASTTransformer that
+// moves the attributes of BlockAttributes nodes to the blocks they apply to.
+//
+// Attributes apply to the block that immediately precedes them. If there is
+// no such block, they apply to the block that immediately follows them.
+func NewBlockAttributesASTTransformer() parser.ASTTransformer {
+	return defaultBlockAttributesASTTransformer
+}
+
+func (a *blockAttributesASTTransformer) CanTransformLazily() bool {
+	return true
+}
+
+func (a *blockAttributesASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	var nodes []gast.Node
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if entering && n.Kind() == ast.KindBlockAttributes {
+			nodes = append(nodes, n)
+		}
+		return gast.WalkContinue, nil
+	})
+
+	source := reader.Source()
+	for _, n := range nodes {
+		// HasBlankPreviousLines is not reliable after blocks that consume their
+		// closing line like fenced code blocks, so we look at the source instead.
+		segment := n.Lines().At(0)
+		var target gast.Node
+		if prev := n.PreviousSibling(); prev != nil && prev.Kind() != ast.KindBlockAttributes &&
+			!isBlankAdjacentLine(source, segment.Start, -1) {
+			target = prev
+		} else if next := n.NextSibling(); next != nil && next.Kind() != ast.KindBlockAttributes &&
+			!isBlankAdjacentLine(source, segment.Stop, 1) {
+			target = next
+		}
+
+		if target == nil {
+			parser.AddDiagnostic(pc, parser.Diagnostic{
+				Severity: parser.DiagnosticWarning,
+				Segment:  segment,
+				Message:  "block attributes do not apply to any block",
+			})
+		} else {
+			for _, attr := range n.Attributes() {
+				setAttribute(target, attr, pc)
+			}
+		}
+		n.Parent().RemoveChild(n.Parent(), n)
+	}
+}
+
+// isBlankAdjacentLine returns true if the line before (direction < 0) or after
+// (direction > 0) the line that contains pos is blank or does not exist.
+// Blockquote markers are ignored.
+func isBlankAdjacentLine(source []byte, pos int, direction int) bool {
+	var line []byte
+	if direction < 0 {
+		start := bytes.LastIndexByte(source[:pos], '\n')
+		if start < 0 {
+			return true
+		}
+		line = source[bytes.LastIndexByte(source[:start], '\n')+1 : start]
+	} else {
+		stop := bytes.IndexByte(source[pos:], '\n')
+		if stop < 0 {
+			return true
+		}
+		line = source[pos+stop+1:]
+		if i := bytes.IndexByte(line, '\n'); i >= 0 {
+			line = line[:i]
+		}
+	}
+	for _, c := range line {
+		if !util.IsSpace(c) && c != '>' {
+			return false
+		}
+	}
+	return true
+}
+
+// setAttribute merges the given attribute into the target like
+// mergeAttribute, and marks an id attribute as used.
+func setAttribute(target gast.Node, attr gast.Attribute, pc parser.Context) {
+	if string(attr.Name) == "id" {
+		if id, ok := attr.Value.([]byte); ok {
+			pc.IDs().Put(id)
+		}
+	}
+	mergeAttribute(target, attr)
+}
+
+// mergeAttribute sets the given attribute to the target. Classes are
+// appended to the existing classes of the target.
+func mergeAttribute(target gast.Node, attr gast.Attribute) {
+	if string(attr.Name) == "class" {
+		if v, ok := target.AttributeString("class"); ok {
+			if class, ok := v.([]byte); ok {
+				value := make([]byte, 0, len(class)+1+len(attr.Value.([]byte)))
+				value = append(value, class...)
+				value = append(append(value, ' '), attr.Value.([]byte)...)
+				target.SetAttribute(attrNameClass, value)
+				return
+			}
+		}
+	}
+	target.SetAttribute(attr.Name, attr.Value)
+}
+
+type blockAttributes struct {
+}
+
+// BlockAttributes is an extension that allows you to attach attributes to
+// blocks with lines like '{: #id .class key="value"}' (kramdown style) or
+// '{#id .class}'.
+var BlockAttributes = &blockAttributes{}
+
+func (e *blockAttributes) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(
+			util.Prioritized(NewBlockAttributesParser(), 50),
+		),
+		parser.WithASTTransformers(
+			util.Prioritized(NewBlockAttributesASTTransformer(), 50),
+		),
+	)
+}
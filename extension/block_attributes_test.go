@@ -0,0 +1,41 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/pgavlin/goldmark"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/testutil"
+	"github.com/pgavlin/goldmark/text"
+)
+
+func TestBlockAttributes(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithParserOptions(
+			parser.WithAttribute(),
+		),
+		goldmark.WithExtensions(
+			BlockAttributes,
+			Table,
+		),
+	)
+	testutil.DoTestCaseFile(markdown, "_test/block_attributes.txt", t, testutil.ParseCliCaseArg()...)
+}
+
+func TestBlockAttributesDiagnostics(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			BlockAttributes,
+		),
+	)
+	source := []byte("para\n\n{: .x}\n\nnext\n")
+	pc := parser.NewContext()
+	markdown.Parser().Parse(text.NewReader(source), parser.WithContext(pc))
+	diagnostics := parser.Diagnostics(pc)
+	if len(diagnostics) != 1 {
+		t.Fatalf("expected 1 diagnostic, got %d", len(diagnostics))
+	}
+	if actual := diagnostics[0].Format(source); actual != "3:1: warning: block attributes do not apply to any block" {
+		t.Errorf("unexpected diagnostic %q", actual)
+	}
+}
@@ -0,0 +1,404 @@
+package extension
+
+import (
+	"bytes"
+
+	"github.com/pgavlin/goldmark"
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/extension/ast"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/renderer"
+	"github.com/pgavlin/goldmark/renderer/html"
+	"github.com/pgavlin/goldmark/renderer/markdown"
+	"github.com/pgavlin/goldmark/text"
+	"github.com/pgavlin/goldmark/util"
+)
+
+// A CitationResolver interface formats citations and bibliographies.
+type CitationResolver interface {
+	// HasEntry returns true if the bibliography has an entry with the given
+	// key. Author-in-text citations like '@smith2020' are parsed only if
+	// HasEntry returns true for their keys.
+	HasEntry(key []byte) bool
+
+	// FormatCitation returns the text of the given citation group.
+	FormatCitation(group *ast.CitationGroup) []byte
+
+	// FormatBibliography returns the entries of the bibliography of the
+	// given cited keys, in the order in which they should be listed.
+	// Keys that are not in the bibliography should be omitted.
+	FormatBibliography(keys [][]byte) []*ast.BibliographyEntry
+}
+
+type citationParser struct {
+	resolver CitationResolver
+}
+
+// NewCitationParser returns a new parser.InlineParser that parses Pandoc
+// style citations like '[see @smith2020, p. 4; -@doe1999]' and '@smith2020'.
+// Bracketed citations must fit on one line. Prefixes and locators are plain
+// text.
+func NewCitationParser(resolver CitationResolver) parser.InlineParser {
+	if resolver == nil {
+		resolver = NewBibliography()
+	}
+	return &citationParser{
+		resolver: resolver,
+	}
+}
+
+func (s *citationParser) Trigger() []byte {
+	return []byte{'[', '@'}
+}
+
+func (s *citationParser) CanParseConcurrently() bool {
+	return true
+}
+
+func (s *citationParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	if pc.IsInLinkLabel() {
+		return nil
+	}
+	line, _ := block.PeekLine()
+	if line[0] == '@' {
+		return s.parseInText(line, block)
+	}
+
+	closes := bytes.IndexAny(line[1:], "[]")
+	if closes < 0 || line[1+closes] != ']' {
+		return nil
+	}
+	closes++
+	// '[@smith2020](url)' and '[@smith2020][ref]' are links.
+	if closes+1 < len(line) && (line[closes+1] == '(' || line[closes+1] == '[') {
+		return nil
+	}
+	group := ast.NewCitationGroup(true)
+	for _, item := range bytes.Split(line[1:closes], []byte{';'}) {
+		citation := parseCitationItem(item)
+		if citation == nil {
+			return nil
+		}
+		group.AppendChild(group, citation)
+	}
+	block.Advance(closes + 1)
+	return group
+}
+
+func (s *citationParser) parseInText(line []byte, block text.Reader) gast.Node {
+	if r := block.PrecendingCharacter(); isWordRune(r) || r == '@' {
+		return nil
+	}
+	n := citationKeyLength(line[1:])
+	if n == 0 || !s.resolver.HasEntry(line[1:1+n]) {
+		return nil
+	}
+	consumes := 1 + n
+	citation := ast.NewCitation(append([]byte{}, line[1:consumes]...))
+
+	// A locator may follow in brackets, like '@smith2020 [p. 4]'.
+	if rest := line[consumes:]; len(rest) > 2 && rest[0] == ' ' && rest[1] == '[' {
+		if closes := bytes.IndexAny(rest[2:], "[]@"); closes >= 0 && rest[2+closes] == ']' {
+			next := 2 + closes + 1
+			if next >= len(rest) || (rest[next] != '(' && rest[next] != '[') {
+				citation.Locator = append([]byte{}, util.TrimRightSpace(util.TrimLeftSpace(rest[2:2+closes]))...)
+				consumes += next
+			}
+		}
+	}
+
+	group := ast.NewCitationGroup(false)
+	group.AppendChild(group, citation)
+	block.Advance(consumes)
+	return group
+}
+
+// parseCitationItem parses an item of a bracketed citation like
+// 'see -@smith2020, p. 4'.
+func parseCitationItem(item []byte) *ast.Citation {
+	for i := 0; i < len(item); i++ {
+		if item[i] != '@' {
+			continue
+		}
+		start, suppress := i, false
+		if i > 0 && item[i-1] == '-' {
+			start, suppress = i-1, true
+		}
+		if start > 0 && !util.IsSpace(item[start-1]) {
+			continue
+		}
+		n := citationKeyLength(item[i+1:])
+		if n == 0 {
+			continue
+		}
+		citation := ast.NewCitation(append([]byte{}, item[i+1:i+1+n]...))
+		citation.SuppressAuthor = suppress
+		if prefix := util.TrimRightSpace(util.TrimLeftSpace(item[:start])); len(prefix) != 0 {
+			citation.Prefix = append([]byte{}, prefix...)
+		}
+		locator := util.TrimLeftSpace(item[i+1+n:])
+		if len(locator) != 0 && locator[0] == ',' {
+			locator = locator[1:]
+		}
+		if locator = util.TrimRightSpace(util.TrimLeftSpace(locator)); len(locator) != 0 {
+			citation.Locator = append([]byte{}, locator...)
+		}
+		return citation
+	}
+	return nil
+}
+
+func isCitationKeyChar(c byte) bool {
+	return c == '_' || util.IsAlphaNumeric(c)
+}
+
+// citationKeyLength returns the length of the citation key at the start of
+// b. Keys start with an alphanumeric character or '_', and may contain
+// internal punctuation like ':' and '.'.
+func citationKeyLength(b []byte) int {
+	if len(b) == 0 || !isCitationKeyChar(b[0]) {
+		return 0
+	}
+	i := 1
+	for i < len(b) {
+		if isCitationKeyChar(b[i]) {
+			i++
+		} else if bytes.IndexByte([]byte(":.#$%&-+?<>~/"), b[i]) >= 0 && i+1 < len(b) && isCitationKeyChar(b[i+1]) {
+			i += 2
+		} else {
+			break
+		}
+	}
+	return i
+}
+
+type citationASTTransformer struct {
+	resolver CitationResolver
+}
+
+// NewCitationASTTransformer returns a new parser.ASTTransformer that formats
+// citations with the given resolver, and appends a bibliography of the cited
+// entries to the document.
+func NewCitationASTTransformer(resolver CitationResolver) parser.ASTTransformer {
+	if resolver == nil {
+		resolver = NewBibliography()
+	}
+	return &citationASTTransformer{
+		resolver: resolver,
+	}
+}
+
+func (a *citationASTTransformer) CanTransformLazily() bool {
+	return true
+}
+
+func (a *citationASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	var groups []*ast.CitationGroup
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if group, ok := n.(*ast.CitationGroup); ok && entering {
+			groups = append(groups, group)
+			return gast.WalkSkipChildren, nil
+		}
+		return gast.WalkContinue, nil
+	})
+	if len(groups) == 0 {
+		return
+	}
+
+	var keys [][]byte
+	seen := map[string]bool{}
+	for _, group := range groups {
+		group.Formatted = a.resolver.FormatCitation(group)
+		for c := group.FirstChild(); c != nil; c = c.NextSibling() {
+			key := c.(*ast.Citation).Key
+			if !seen[string(key)] {
+				seen[string(key)] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	entries := a.resolver.FormatBibliography(keys)
+	if len(entries) == 0 {
+		return
+	}
+	bibliography := ast.NewBibliography()
+	for _, entry := range entries {
+		bibliography.AppendChild(bibliography, entry)
+	}
+	node.AppendChild(node, bibliography)
+}
+
+// CitationHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders CitationGroup, Bibliography and BibliographyEntry nodes.
+type CitationHTMLRenderer struct {
+	html.Config
+}
+
+// NewCitationHTMLRenderer returns a new CitationHTMLRenderer.
+func NewCitationHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	r := &CitationHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *CitationHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindCitationGroup, r.renderCitationGroup)
+	reg.Register(ast.KindBibliography, r.renderBibliography)
+	reg.Register(ast.KindBibliographyEntry, r.renderBibliographyEntry)
+}
+
+func (r *CitationHTMLRenderer) renderCitationGroup(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	n := node.(*ast.CitationGroup)
+	_, _ = w.WriteString(`<span class="citation" data-cites="`)
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		if c != n.FirstChild() {
+			_ = w.WriteByte(' ')
+		}
+		_, _ = w.Write(util.EscapeHTML(c.(*ast.Citation).Key))
+	}
+	_, _ = w.WriteString(`">`)
+	_, _ = w.Write(util.EscapeHTML(n.Formatted))
+	_, _ = w.WriteString("</span>")
+	return gast.WalkSkipChildren, nil
+}
+
+func (r *CitationHTMLRenderer) renderBibliography(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if entering {
+		_, _ = w.WriteString(`<div id="refs" class="references" role="doc-bibliography"`)
+		if node.Attributes() != nil {
+			html.RenderAttributes(w, node, html.GlobalAttributeFilter)
+		}
+		_, _ = w.WriteString(">\n")
+	} else {
+		_, _ = w.WriteString("</div>\n")
+	}
+	return gast.WalkContinue, nil
+}
+
+func (r *CitationHTMLRenderer) renderBibliographyEntry(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	n := node.(*ast.BibliographyEntry)
+	_, _ = w.WriteString(`<div id="ref-`)
+	_, _ = w.Write(util.EscapeHTML(n.Key))
+	_, _ = w.WriteString(`" class="csl-entry" role="doc-biblioentry">`)
+	_, _ = w.Write(util.EscapeHTML(n.Formatted))
+	_, _ = w.WriteString("</div>\n")
+	return gast.WalkSkipChildren, nil
+}
+
+// CitationMarkdownRenderer is a renderer.NodeRenderer implementation that
+// writes CitationGroup nodes back to Markdown using a markdown.Renderer.
+// Bibliography nodes are generated, so they are not written.
+type CitationMarkdownRenderer struct {
+	*markdown.Renderer
+}
+
+// NewCitationMarkdownRenderer returns a new CitationMarkdownRenderer that
+// writes through the given markdown.Renderer.
+func NewCitationMarkdownRenderer(r *markdown.Renderer) renderer.NodeRenderer {
+	return &CitationMarkdownRenderer{r}
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *CitationMarkdownRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindCitationGroup, r.renderCitationGroup)
+	reg.Register(ast.KindBibliography, r.renderBibliography)
+}
+
+func (r *CitationMarkdownRenderer) renderCitationGroup(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	n := node.(*ast.CitationGroup)
+	var buf bytes.Buffer
+	if n.Bracketed {
+		buf.WriteByte('[')
+	}
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		citation := c.(*ast.Citation)
+		if c != n.FirstChild() {
+			buf.WriteString("; ")
+		}
+		if len(citation.Prefix) != 0 {
+			buf.Write(citation.Prefix)
+			buf.WriteByte(' ')
+		}
+		if citation.SuppressAuthor {
+			buf.WriteByte('-')
+		}
+		buf.WriteByte('@')
+		buf.Write(citation.Key)
+		if len(citation.Locator) != 0 {
+			if n.Bracketed {
+				buf.WriteString(", ")
+				buf.Write(citation.Locator)
+			} else {
+				buf.WriteString(" [")
+				buf.Write(citation.Locator)
+				buf.WriteByte(']')
+			}
+		}
+	}
+	if n.Bracketed {
+		buf.WriteByte(']')
+	}
+	if _, err := r.Write(w, buf.Bytes()); err != nil {
+		return gast.WalkStop, err
+	}
+	return gast.WalkSkipChildren, nil
+}
+
+func (r *CitationMarkdownRenderer) renderBibliography(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	return gast.WalkSkipChildren, nil
+}
+
+type citations struct {
+	resolver CitationResolver
+}
+
+// Citation is an extension that allows you to use Pandoc style citations.
+// Citation does not know any bibliography entries; use NewCitation to
+// set a CitationResolver such as a Bibliography.
+var Citation = NewCitation(nil)
+
+// NewCitation returns a new extension that formats citations with the
+// given resolver. If resolver is nil, an empty Bibliography is used.
+func NewCitation(resolver CitationResolver) goldmark.Extender {
+	if resolver == nil {
+		resolver = NewBibliography()
+	}
+	return &citations{
+		resolver: resolver,
+	}
+}
+
+func (e *citations) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithInlineParsers(
+			util.Prioritized(NewCitationParser(e.resolver), 199),
+		),
+		// The bibliography is appended before footnotes.
+		parser.WithASTTransformers(
+			util.Prioritized(NewCitationASTTransformer(e.resolver), 998),
+		),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewCitationHTMLRenderer(), 500),
+	))
+}
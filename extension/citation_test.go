@@ -0,0 +1,132 @@
+package extension
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/pgavlin/goldmark"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/testutil"
+	"github.com/pgavlin/goldmark/text"
+)
+
+func TestCitation(t *testing.T) {
+	bibliography, err := LoadBibliography(os.DirFS("_test"), "citation.bib")
+	if err != nil {
+		t.Fatal(err)
+	}
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewCitation(bibliography),
+		),
+	)
+	testutil.DoTestCaseFile(markdown, "_test/citation.txt", t, testutil.ParseCliCaseArg()...)
+}
+
+func TestCitationCSLJSON(t *testing.T) {
+	bibliography, err := LoadBibliography(os.DirFS("_test"), "citation.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	md := goldmark.New(goldmark.WithExtensions(NewCitation(bibliography), Footnote))
+	var buf bytes.Buffer
+	if err := md.Convert([]byte("@smith2020 and [@who2021].[^1]\n\n[^1]: Note.\n"), &buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := `<p><span class="citation" data-cites="smith2020">Smith and Doe (2020)</span> and <span class="citation" data-cites="who2021">(World Health Organization 2021)</span>.<sup id="fnref:1"><a href="#fn:1" class="footnote-ref" role="doc-noteref">1</a></sup></p>
+<div id="refs" class="references" role="doc-bibliography">
+<div id="ref-smith2020" class="csl-entry" role="doc-biblioentry">Smith, John, and Jane Doe. 2020. Markdown in Practice. Example Press.</div>
+<div id="ref-who2021" class="csl-entry" role="doc-biblioentry">World Health Organization. 2021. Annual Report. WHO.</div>
+</div>
+<div class="footnotes" role="doc-endnotes">
+<hr>
+<ol>
+<li id="fn:1">
+<p>Note.&#160;<a href="#fnref:1" class="footnote-backref" role="doc-backlink">&#x21a9;&#xfe0e;</a></p>
+</li>
+</ol>
+</div>
+`
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestCitationLazyInlines(t *testing.T) {
+	bibliography, err := LoadBibliography(os.DirFS("_test"), "citation.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	md := goldmark.New(goldmark.WithExtensions(NewCitation(bibliography)))
+	source := []byte("@smith2020 and [@who2021].\n")
+	var expected bytes.Buffer
+	if err := md.Convert(source, &expected); err != nil {
+		t.Fatal(err)
+	}
+	doc := md.Parser().Parse(text.NewReader(source), parser.WithLazyInlines())
+	var buf bytes.Buffer
+	if err := md.Renderer().Render(&buf, source, doc); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != expected.String() || !strings.Contains(buf.String(), `id="refs"`) {
+		t.Errorf("expected %q, got %q", expected.String(), buf.String())
+	}
+}
+
+func TestLoadBibliographyErrors(t *testing.T) {
+	fsys := fstest.MapFS{
+		"refs.txt":   {Data: []byte("")},
+		"bad.bib":    {Data: []byte("@book{key, title = {unterminated")},
+		"nokey.json": {Data: []byte(`[{"title": "No ID"}]`)},
+	}
+	for _, name := range []string{"refs.txt", "bad.bib", "nokey.json", "missing.bib"} {
+		if _, err := LoadBibliography(fsys, name); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestParseBibTeXNonASCIIAuthors(t *testing.T) {
+	cases := []struct {
+		authors  string
+		expected []BibliographyName
+	}{
+		{
+			authors:  "İİİİİİ and Smith, John",
+			expected: []BibliographyName{{Family: "İİİİİİ"}, {Family: "Smith", Given: "John"}},
+		},
+		{
+			authors:  "ȺȺȺȺ and Smith, John",
+			expected: []BibliographyName{{Family: "ȺȺȺȺ"}, {Family: "Smith", Given: "John"}},
+		},
+		{
+			authors:  "Müller, Jürgen AND Çelik, Ayşe",
+			expected: []BibliographyName{{Family: "Müller", Given: "Jürgen"}, {Family: "Çelik", Given: "Ayşe"}},
+		},
+		{
+			authors:  "\xff\xfe and Doe, Jane",
+			expected: []BibliographyName{{Family: "\xff\xfe"}, {Family: "Doe", Given: "Jane"}},
+		},
+	}
+	for _, c := range cases {
+		b, err := ParseBibTeX([]byte("@book{key, author = {" + c.authors + "}, title = {T}}"))
+		if err != nil {
+			t.Fatalf("%q: %v", c.authors, err)
+		}
+		item, ok := b.Lookup("key")
+		if !ok {
+			t.Fatalf("%q: missing item", c.authors)
+		}
+		if len(item.Authors) != len(c.expected) {
+			t.Fatalf("%q: expected %v, got %v", c.authors, c.expected, item.Authors)
+		}
+		for i, name := range item.Authors {
+			if name != c.expected[i] {
+				t.Errorf("%q: expected %v, got %v", c.authors, c.expected, item.Authors)
+			}
+		}
+	}
+}
@@ -0,0 +1,562 @@
+package extension
+
+import (
+	"bytes"
+
+	"github.com/pgavlin/goldmark"
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/extension/ast"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/renderer"
+	"github.com/pgavlin/goldmark/renderer/html"
+	"github.com/pgavlin/goldmark/renderer/markdown"
+	"github.com/pgavlin/goldmark/text"
+	"github.com/pgavlin/goldmark/util"
+)
+
+type criticMarker struct {
+	typ    ast.CriticMarkupType
+	opener []byte
+	closer []byte
+}
+
+var criticMarkers = []criticMarker{
+	{ast.CriticAddition, []byte("{++"), []byte("++}")},
+	{ast.CriticDeletion, []byte("{--"), []byte("--}")},
+	{ast.CriticSubstitution, []byte("{~~"), []byte("~~}")},
+	{ast.CriticHighlight, []byte("{=="), []byte("==}")},
+	{ast.CriticComment, []byte("{>>"), []byte("<<}")},
+}
+
+var criticSubstitutionSeparator = []byte("~>")
+
+func findCriticMarker(typ ast.CriticMarkupType) criticMarker {
+	for _, m := range criticMarkers {
+		if m.typ == typ {
+			return m
+		}
+	}
+	return criticMarker{}
+}
+
+// A CriticMarkupMode is a mode of rendering CriticMarkup changes.
+type CriticMarkupMode int
+
+const (
+	// CriticMarkupShow shows changes as '<ins>', '<del>' and '<mark>'
+	// elements and comments as '<span class="critic comment">' elements.
+	CriticMarkupShow CriticMarkupMode = iota
+
+	// CriticMarkupAccept accepts all changes: additions, the new text of
+	// substitutions and highlighted text are kept, and deletions and
+	// comments are removed.
+	CriticMarkupAccept
+
+	// CriticMarkupReject rejects all changes: deletions, the old text of
+	// substitutions and highlighted text are kept, and additions and
+	// comments are removed.
+	CriticMarkupReject
+)
+
+// keepsCriticMarkup returns true if the contents of a change of the given
+// type are kept when changes are accepted or rejected in the given mode.
+func keepsCriticMarkup(typ ast.CriticMarkupType, mode CriticMarkupMode) bool {
+	switch typ {
+	case ast.CriticAddition:
+		return mode == CriticMarkupAccept
+	case ast.CriticDeletion:
+		return mode == CriticMarkupReject
+	case ast.CriticSubstitution, ast.CriticHighlight:
+		return true
+	}
+	return false
+}
+
+// CriticMarkupConfig struct holds options for the extension.
+type CriticMarkupConfig struct {
+	// Mode is a mode of rendering changes.
+	Mode CriticMarkupMode
+}
+
+// NewCriticMarkupConfig returns a new CriticMarkupConfig with defaults.
+func NewCriticMarkupConfig() CriticMarkupConfig {
+	return CriticMarkupConfig{
+		Mode: CriticMarkupShow,
+	}
+}
+
+// A CriticMarkupOption sets options for CriticMarkup.
+type CriticMarkupOption func(*CriticMarkupConfig)
+
+// WithCriticMarkupMode is a functional option that sets a mode of rendering
+// changes.
+func WithCriticMarkupMode(mode CriticMarkupMode) CriticMarkupOption {
+	return func(c *CriticMarkupConfig) {
+		c.Mode = mode
+	}
+}
+
+type criticMarkupParser struct {
+	parser parser.Parser
+}
+
+// NewCriticMarkupParser returns a new InlineParser that parses CriticMarkup
+// changes like '{++added++}', '{--deleted--}', '{~~old~>new~~}',
+// '{==highlighted==}' and '{>>comment<<}'. Changes may span lines, but not
+// paragraphs. The contents of changes are parsed with the given parser.
+func NewCriticMarkupParser(p parser.Parser) parser.InlineParser {
+	return &criticMarkupParser{
+		parser: p,
+	}
+}
+
+func (s *criticMarkupParser) Trigger() []byte {
+	return []byte{'{'}
+}
+
+func (s *criticMarkupParser) CanParseConcurrently() bool {
+	return true
+}
+
+func (s *criticMarkupParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	line, _ := block.PeekLine()
+	var marker *criticMarker
+	for i := range criticMarkers {
+		if bytes.HasPrefix(line, criticMarkers[i].opener) {
+			marker = &criticMarkers[i]
+			break
+		}
+	}
+	if marker == nil {
+		return nil
+	}
+	savedLine, savedPosition := block.Position()
+	block.Advance(len(marker.opener))
+	segments, ok := findCriticMarkupCloser(block, marker.closer)
+	if !ok {
+		block.SetPosition(savedLine, savedPosition)
+		return nil
+	}
+
+	node := ast.NewCriticMarkup(marker.typ)
+	if marker.typ != ast.CriticSubstitution {
+		s.parseContents(node, segments, block.Source(), pc)
+		return node
+	}
+	before, after, ok := splitCriticSubstitution(block.Source(), segments)
+	if !ok {
+		block.SetPosition(savedLine, savedPosition)
+		return nil
+	}
+	deletion := ast.NewCriticMarkup(ast.CriticDeletion)
+	s.parseContents(deletion, before, block.Source(), pc)
+	node.AppendChild(node, deletion)
+	addition := ast.NewCriticMarkup(ast.CriticAddition)
+	s.parseContents(addition, after, block.Source(), pc)
+	node.AppendChild(node, addition)
+	return node
+}
+
+// parseContents parses the given contents of a change into children of the
+// given node. Spaces around the contents are kept as text, because they
+// matter when changes are accepted or rejected, like in 'word{++ more++}'.
+func (s *criticMarkupParser) parseContents(node gast.Node, segments *text.Segments, source []byte, pc parser.Context) {
+	if segments.Len() == 0 {
+		return
+	}
+	first, last := segments.At(0), segments.At(segments.Len()-1)
+	leading := first.Start
+	for ; leading < first.Stop && (source[leading] == ' ' || source[leading] == '\t'); leading++ {
+	}
+	if segments.Len() == 1 && leading == first.Stop {
+		if !first.IsEmpty() {
+			node.AppendChild(node, gast.NewTextSegment(first))
+		}
+		return
+	}
+	trailing := last.Stop
+	for ; trailing > last.Start && (source[trailing-1] == ' ' || source[trailing-1] == '\t'); trailing-- {
+	}
+
+	contents := text.NewSegments()
+	for i := 0; i < segments.Len(); i++ {
+		segment := segments.At(i)
+		if i == 0 {
+			segment = segment.WithStart(leading)
+		}
+		if i == segments.Len()-1 {
+			segment = segment.WithStop(trailing)
+		}
+		contents.Append(segment)
+	}
+	if leading > first.Start {
+		node.AppendChild(node, gast.NewTextSegment(text.NewSegment(first.Start, leading)))
+	}
+	parseNestedInlines(s.parser, node, contents, source, pc)
+	if trailing < last.Stop {
+		node.AppendChild(node, gast.NewTextSegment(text.NewSegment(trailing, last.Stop)))
+	}
+}
+
+// findCriticMarkupCloser advances the given reader past the given closer
+// and returns the segments before the closer.
+func findCriticMarkupCloser(block text.Reader, closer []byte) (*text.Segments, bool) {
+	segments := text.NewSegments()
+	for {
+		line, segment := block.PeekLine()
+		if line == nil {
+			return nil, false
+		}
+		if i := bytes.Index(line, closer); i >= 0 {
+			segments.Append(segment.WithStop(segment.Start + i))
+			block.Advance(i + len(closer))
+			return segments, true
+		}
+		segments.Append(segment)
+		block.AdvanceLine()
+	}
+}
+
+// splitCriticSubstitution splits the given segments of a substitution into
+// the segments of the old text and the segments of the new text.
+func splitCriticSubstitution(source []byte, segments *text.Segments) (*text.Segments, *text.Segments, bool) {
+	before, after := text.NewSegments(), text.NewSegments()
+	found := false
+	for i := 0; i < segments.Len(); i++ {
+		segment := segments.At(i)
+		if found {
+			after.Append(segment)
+			continue
+		}
+		if j := bytes.Index(segment.Value(source), criticSubstitutionSeparator); j >= 0 {
+			before.Append(segment.WithStop(segment.Start + j))
+			after.Append(segment.WithStart(segment.Start + j + len(criticSubstitutionSeparator)))
+			found = true
+			continue
+		}
+		before.Append(segment)
+	}
+	return before, after, found
+}
+
+type criticMarkupBlockParser struct {
+}
+
+var defaultCriticMarkupBlockParser = &criticMarkupBlockParser{}
+
+// NewCriticMarkupBlockParser returns a new BlockParser that parses
+// CriticMarkup changes of whole blocks, which start with a line like '{++'
+// and end with a line like '++}'. Additions, deletions and comments can
+// change whole blocks.
+func NewCriticMarkupBlockParser() parser.BlockParser {
+	return defaultCriticMarkupBlockParser
+}
+
+func (b *criticMarkupBlockParser) Trigger() []byte {
+	return []byte{'{'}
+}
+
+func (b *criticMarkupBlockParser) Open(parent gast.Node, reader text.Reader, pc parser.Context) (gast.Node, parser.State) {
+	line, _ := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 {
+		return nil, parser.NoChildren
+	}
+	line = line[pos:]
+	for _, m := range criticMarkers {
+		switch m.typ {
+		case ast.CriticAddition, ast.CriticDeletion, ast.CriticComment:
+		default:
+			continue
+		}
+		if bytes.HasPrefix(line, m.opener) && util.IsBlank(line[len(m.opener):]) {
+			reader.AdvanceToEOL()
+			return ast.NewCriticMarkupBlock(m.typ), parser.HasChildren
+		}
+	}
+	return nil, parser.NoChildren
+}
+
+func (b *criticMarkupBlockParser) Continue(node gast.Node, reader text.Reader, pc parser.Context) parser.State {
+	n := node.(*ast.CriticMarkupBlock)
+	line, _ := reader.PeekLine()
+	w, pos := util.IndentWidth(line, reader.LineOffset())
+	closer := findCriticMarker(n.CriticMarkupType).closer
+	if w < 4 && bytes.HasPrefix(line[pos:], closer) && util.IsBlank(line[pos+len(closer):]) &&
+		!hasOpenCriticMarkupBlock(n, pc) {
+		reader.AdvanceToEOL()
+		return parser.Close
+	}
+	return parser.Continue | parser.HasChildren
+}
+
+// hasOpenCriticMarkupBlock returns true if a change of the same type as the
+// given change is opened inside it, so that a closing line closes that
+// change instead.
+func hasOpenCriticMarkupBlock(node *ast.CriticMarkupBlock, pc parser.Context) bool {
+	inside := false
+	for _, b := range pc.OpenedBlocks() {
+		if b.Node == node {
+			inside = true
+			continue
+		}
+		if c, ok := b.Node.(*ast.CriticMarkupBlock); inside && ok && c.CriticMarkupType == node.CriticMarkupType {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *criticMarkupBlockParser) Close(node gast.Node, reader text.Reader, pc parser.Context) {
+	// nothing to do
+}
+
+func (b *criticMarkupBlockParser) CanInterruptParagraph() bool {
+	return false
+}
+
+func (b *criticMarkupBlockParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+// AcceptCriticMarkup accepts all CriticMarkup changes in the given node, as
+// described by CriticMarkupAccept. The changes are replaced by the kept
+// contents.
+func AcceptCriticMarkup(node gast.Node) {
+	applyCriticMarkup(node, CriticMarkupAccept)
+}
+
+// RejectCriticMarkup rejects all CriticMarkup changes in the given node, as
+// described by CriticMarkupReject. The changes are replaced by the kept
+// contents.
+func RejectCriticMarkup(node gast.Node) {
+	applyCriticMarkup(node, CriticMarkupReject)
+}
+
+func applyCriticMarkup(node gast.Node, mode CriticMarkupMode) {
+	if mode == CriticMarkupShow {
+		return
+	}
+	var changes []gast.Node
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if entering && (n.Kind() == ast.KindCriticMarkup || n.Kind() == ast.KindCriticMarkupBlock) {
+			changes = append(changes, n)
+		}
+		return gast.WalkContinue, nil
+	})
+	for _, change := range changes {
+		parent := change.Parent()
+		if parent == nil {
+			continue
+		}
+		var typ ast.CriticMarkupType
+		switch n := change.(type) {
+		case *ast.CriticMarkup:
+			typ = n.CriticMarkupType
+		case *ast.CriticMarkupBlock:
+			typ = n.CriticMarkupType
+		}
+		if keepsCriticMarkup(typ, mode) {
+			for c := change.FirstChild(); c != nil; {
+				next := c.NextSibling()
+				parent.InsertBefore(parent, change, c)
+				c = next
+			}
+		}
+		parent.RemoveChild(parent, change)
+	}
+}
+
+type criticMarkupASTTransformer struct {
+	mode CriticMarkupMode
+}
+
+// NewCriticMarkupASTTransformer returns a new parser.ASTTransformer that
+// accepts or rejects all CriticMarkup changes in a document in the given
+// mode, for example to write out the accepted document with a Markdown
+// renderer.
+func NewCriticMarkupASTTransformer(mode CriticMarkupMode) parser.ASTTransformer {
+	return &criticMarkupASTTransformer{
+		mode: mode,
+	}
+}
+
+func (a *criticMarkupASTTransformer) CanTransformLazily() bool {
+	return true
+}
+
+func (a *criticMarkupASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	applyCriticMarkup(node, a.mode)
+}
+
+// CriticMarkupHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders CriticMarkup and CriticMarkupBlock nodes.
+type CriticMarkupHTMLRenderer struct {
+	html.Config
+	CriticMarkupConfig
+}
+
+// NewCriticMarkupHTMLRenderer returns a new CriticMarkupHTMLRenderer.
+func NewCriticMarkupHTMLRenderer(opts ...CriticMarkupOption) renderer.NodeRenderer {
+	r := &CriticMarkupHTMLRenderer{
+		Config:             html.NewConfig(),
+		CriticMarkupConfig: NewCriticMarkupConfig(),
+	}
+	for _, o := range opts {
+		o(&r.CriticMarkupConfig)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *CriticMarkupHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindCriticMarkup, r.renderCriticMarkup)
+	reg.Register(ast.KindCriticMarkupBlock, r.renderCriticMarkupBlock)
+}
+
+func (r *CriticMarkupHTMLRenderer) renderCriticMarkup(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	n := node.(*ast.CriticMarkup)
+	if r.Mode != CriticMarkupShow {
+		if !keepsCriticMarkup(n.CriticMarkupType, r.Mode) {
+			return gast.WalkSkipChildren, nil
+		}
+		return gast.WalkContinue, nil
+	}
+	var open, close string
+	switch n.CriticMarkupType {
+	case ast.CriticAddition:
+		open, close = "<ins>", "</ins>"
+	case ast.CriticDeletion:
+		open, close = "<del>", "</del>"
+	case ast.CriticHighlight:
+		open, close = "<mark>", "</mark>"
+	case ast.CriticComment:
+		open, close = `<span class="critic comment">`, "</span>"
+	}
+	if entering {
+		_, _ = w.WriteString(open)
+	} else {
+		_, _ = w.WriteString(close)
+	}
+	return gast.WalkContinue, nil
+}
+
+func (r *CriticMarkupHTMLRenderer) renderCriticMarkupBlock(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	n := node.(*ast.CriticMarkupBlock)
+	if r.Mode != CriticMarkupShow {
+		if !keepsCriticMarkup(n.CriticMarkupType, r.Mode) {
+			return gast.WalkSkipChildren, nil
+		}
+		return gast.WalkContinue, nil
+	}
+	var open, close string
+	switch n.CriticMarkupType {
+	case ast.CriticAddition:
+		open, close = "<ins>\n", "</ins>\n"
+	case ast.CriticDeletion:
+		open, close = "<del>\n", "</del>\n"
+	case ast.CriticComment:
+		open, close = "<div class=\"critic comment\">\n", "</div>\n"
+	}
+	if entering {
+		_, _ = w.WriteString(open)
+	} else {
+		_, _ = w.WriteString(close)
+	}
+	return gast.WalkContinue, nil
+}
+
+// CriticMarkupMarkdownRenderer is a renderer.NodeRenderer implementation
+// that writes CriticMarkup and CriticMarkupBlock nodes back to Markdown
+// using a markdown.Renderer. Use AcceptCriticMarkup, RejectCriticMarkup or
+// a transformer returned by NewCriticMarkupASTTransformer to write out the
+// accepted or rejected document instead.
+type CriticMarkupMarkdownRenderer struct {
+	*markdown.Renderer
+}
+
+// NewCriticMarkupMarkdownRenderer returns a new CriticMarkupMarkdownRenderer
+// that writes through the given markdown.Renderer.
+func NewCriticMarkupMarkdownRenderer(r *markdown.Renderer) renderer.NodeRenderer {
+	return &CriticMarkupMarkdownRenderer{r}
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *CriticMarkupMarkdownRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindCriticMarkup, r.renderCriticMarkup)
+	reg.Register(ast.KindCriticMarkupBlock, r.renderCriticMarkupBlock)
+}
+
+func (r *CriticMarkupMarkdownRenderer) renderCriticMarkup(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	n := node.(*ast.CriticMarkup)
+	var marker []byte
+	if p, ok := n.Parent().(*ast.CriticMarkup); ok && p.CriticMarkupType == ast.CriticSubstitution {
+		// The old text of a substitution is followed by a separator.
+		if !entering && n.CriticMarkupType == ast.CriticDeletion {
+			marker = criticSubstitutionSeparator
+		}
+	} else if m := findCriticMarker(n.CriticMarkupType); entering {
+		marker = m.opener
+	} else {
+		marker = m.closer
+	}
+	if _, err := r.Write(w, marker); err != nil {
+		return gast.WalkStop, err
+	}
+	return gast.WalkContinue, nil
+}
+
+func (r *CriticMarkupMarkdownRenderer) renderCriticMarkupBlock(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	n := node.(*ast.CriticMarkupBlock)
+	m := findCriticMarker(n.CriticMarkupType)
+	if !entering {
+		if _, err := r.Write(w, append(append([]byte{}, m.closer...), '\n')); err != nil {
+			return gast.WalkStop, err
+		}
+		if err := r.CloseBlock(w); err != nil {
+			return gast.WalkStop, err
+		}
+		return gast.WalkContinue, nil
+	}
+	if err := r.OpenBlock(w, source, n); err != nil {
+		return gast.WalkStop, err
+	}
+	if _, err := r.Write(w, append(append([]byte{}, m.opener...), '\n')); err != nil {
+		return gast.WalkStop, err
+	}
+	return gast.WalkContinue, nil
+}
+
+type criticMarkup struct {
+	options []CriticMarkupOption
+}
+
+// CriticMarkup is an extension that allows you to use CriticMarkup changes
+// like '{++added++}', '{--deleted--}', '{~~old~>new~~}', '{==highlighted==}'
+// and '{>>comment<<}'.
+var CriticMarkup = &criticMarkup{}
+
+// NewCriticMarkup returns a new extension with given options.
+func NewCriticMarkup(opts ...CriticMarkupOption) goldmark.Extender {
+	return &criticMarkup{
+		options: opts,
+	}
+}
+
+func (e *criticMarkup) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(
+			util.Prioritized(NewCriticMarkupBlockParser(), 710),
+		),
+		parser.WithInlineParsers(
+			util.Prioritized(NewCriticMarkupParser(m.Parser()), 150),
+		),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewCriticMarkupHTMLRenderer(e.options...), 500),
+	))
+}
@@ -0,0 +1,123 @@
+package extension
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pgavlin/goldmark"
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/renderer"
+	"github.com/pgavlin/goldmark/renderer/markdown"
+	"github.com/pgavlin/goldmark/testutil"
+	"github.com/pgavlin/goldmark/text"
+	"github.com/pgavlin/goldmark/util"
+)
+
+func TestCriticMarkup(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			CriticMarkup,
+		),
+	)
+	testutil.DoTestCaseFile(markdown, "_test/critic.txt", t, testutil.ParseCliCaseArg()...)
+}
+
+const criticMarkupSource = `Keep {++added++}{--deleted--} {~~old~>new~~} {==text==}{>>comment<<}.
+
+{++
+Added paragraph.
+++}
+
+{--
+Deleted paragraph.
+--}
+`
+
+func TestCriticMarkupModes(t *testing.T) {
+	cases := []struct {
+		mode     CriticMarkupMode
+		expected string
+	}{
+		{
+			mode: CriticMarkupAccept,
+			expected: `<p>Keep added new text.</p>
+<p>Added paragraph.</p>`,
+		},
+		{
+			mode: CriticMarkupReject,
+			expected: `<p>Keep deleted old text.</p>
+<p>Deleted paragraph.</p>`,
+		},
+	}
+	for i, c := range cases {
+		markdown := goldmark.New(
+			goldmark.WithExtensions(
+				NewCriticMarkup(WithCriticMarkupMode(c.mode)),
+			),
+		)
+		testutil.DoTestCase(
+			markdown,
+			testutil.MarkdownTestCase{
+				No:          i + 1,
+				Description: "CriticMarkup mode",
+				Markdown:    criticMarkupSource,
+				Expected:    c.expected,
+			},
+			t,
+		)
+	}
+}
+
+func TestCriticMarkupMarkdownRenderer(t *testing.T) {
+	cases := []struct {
+		apply    func(gast.Node)
+		expected string
+	}{
+		{
+			apply:    AcceptCriticMarkup,
+			expected: "Keep added new text.\n\nAdded paragraph.\n",
+		},
+		{
+			apply:    RejectCriticMarkup,
+			expected: "Keep deleted old text.\n\nDeleted paragraph.\n",
+		},
+	}
+	md := goldmark.New(goldmark.WithExtensions(CriticMarkup))
+	source := []byte(criticMarkupSource)
+	for _, c := range cases {
+		doc := md.Parser().Parse(text.NewReader(source), parser.WithLazyInlines())
+		c.apply(doc)
+		mr := &markdown.Renderer{}
+		r := renderer.NewRenderer(renderer.WithNodeRenderers(
+			util.Prioritized(mr, 100),
+			util.Prioritized(NewCriticMarkupMarkdownRenderer(mr), 100),
+		))
+		var buf bytes.Buffer
+		if err := r.Render(&buf, source, doc); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != c.expected {
+			t.Errorf("expected %q, got %q", c.expected, buf.String())
+		}
+	}
+}
+
+func TestCriticMarkupASTTransformer(t *testing.T) {
+	md := goldmark.New(
+		goldmark.WithExtensions(CriticMarkup),
+		goldmark.WithParserOptions(
+			parser.WithASTTransformers(
+				util.Prioritized(NewCriticMarkupASTTransformer(CriticMarkupAccept), 100),
+			),
+		),
+	)
+	var buf bytes.Buffer
+	if err := md.Convert([]byte(criticMarkupSource), &buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := "<p>Keep added new text.</p>\n<p>Added paragraph.</p>\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
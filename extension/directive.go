@@ -0,0 +1,495 @@
+package extension
+
+import (
+	"bytes"
+
+	"github.com/pgavlin/goldmark"
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/extension/ast"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/renderer"
+	"github.com/pgavlin/goldmark/renderer/html"
+	"github.com/pgavlin/goldmark/renderer/markdown"
+	"github.com/pgavlin/goldmark/text"
+	"github.com/pgavlin/goldmark/util"
+)
+
+// directiveNameLength returns a length of the directive name at the start of
+// the given bytes, or 0 if there is no name. Names start with a letter and
+// consist of letters, digits, '-' and '_'.
+func directiveNameLength(b []byte) int {
+	if len(b) == 0 || !(b[0] >= 'a' && b[0] <= 'z' || b[0] >= 'A' && b[0] <= 'Z') {
+		return 0
+	}
+	i := 1
+	for ; i < len(b) && (util.IsAlphaNumeric(b[i]) || b[i] == '-' || b[i] == '_'); i++ {
+	}
+	return i
+}
+
+// findDirectiveLabelEnd returns a position of the ']' that closes the label
+// that starts with the '[' at the given position, or -1 if there is no such
+// bracket. Brackets in labels must be balanced or escaped.
+func findDirectiveLabelEnd(line []byte, start int) int {
+	depth := 0
+	for i := start; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parseDirectiveAttributes parses the attributes of a directive, if any, and
+// sets them to the given node. parseDirectiveAttributes returns false if the
+// attributes are invalid.
+func parseDirectiveAttributes(node gast.Node, reader text.Reader) bool {
+	if reader.Peek() != '{' {
+		return true
+	}
+	attrs, ok := parser.ParseAttributes(reader)
+	if !ok {
+		return false
+	}
+	for _, attr := range attrs {
+		node.SetAttribute(attr.Name, attr.Value)
+	}
+	return true
+}
+
+type inlineDirectiveParser struct {
+	parser parser.Parser
+}
+
+// NewInlineDirectiveParser returns a new InlineParser that parses inline
+// directives like ':name[label]{attributes}'. An inline directive must have
+// a label or attributes, must fit on one line, and must not follow a letter,
+// a digit or a colon. Labels are parsed with the given parser.
+func NewInlineDirectiveParser(p parser.Parser) parser.InlineParser {
+	return &inlineDirectiveParser{
+		parser: p,
+	}
+}
+
+func (s *inlineDirectiveParser) Trigger() []byte {
+	return []byte{':'}
+}
+
+func (s *inlineDirectiveParser) CanParseConcurrently() bool {
+	return true
+}
+
+func (s *inlineDirectiveParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	line, segment := block.PeekLine()
+	if segment.Start > 0 {
+		if c := block.Source()[segment.Start-1]; util.IsAlphaNumeric(c) || c == ':' {
+			return nil
+		}
+	}
+	l := directiveNameLength(line[1:])
+	if l == 0 {
+		return nil
+	}
+	i := 1 + l
+	node := ast.NewDirective(ast.DirectiveInline, append([]byte{}, line[1:i]...))
+	var label text.Segment
+	if i < len(line) && line[i] == '[' {
+		end := findDirectiveLabelEnd(line, i)
+		if end < 0 {
+			return nil
+		}
+		node.HasLabel = true
+		label = text.NewSegment(segment.Start+i+1, segment.Start+end)
+		i = end + 1
+	}
+	savedLine, savedPosition := block.Position()
+	block.Advance(i)
+	if !parseDirectiveAttributes(node, block) || !node.HasLabel && node.Attributes() == nil {
+		block.SetPosition(savedLine, savedPosition)
+		return nil
+	}
+	if node.HasLabel && !label.IsEmpty() {
+		lines := text.NewSegments()
+		lines.Append(label)
+		parseNestedInlines(s.parser, node, lines, block.Source(), pc)
+	}
+	return node
+}
+
+type directiveParser struct {
+}
+
+var defaultDirectiveParser = &directiveParser{}
+
+// NewDirectiveParser returns a new BlockParser that parses leaf directives
+// like '::name[label]{attributes}' and container directives that start with
+// a line like ':::name[label]{attributes}' and end with a line of at least
+// as many colons.
+func NewDirectiveParser() parser.BlockParser {
+	return defaultDirectiveParser
+}
+
+func (b *directiveParser) Trigger() []byte {
+	return []byte{':'}
+}
+
+func (b *directiveParser) Open(parent gast.Node, reader text.Reader, pc parser.Context) (gast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 || line[pos] != ':' {
+		return nil, parser.NoChildren
+	}
+	i := pos
+	for ; i < len(line) && line[i] == ':'; i++ {
+	}
+	fence := i - pos
+	l := directiveNameLength(line[i:])
+	if fence < 2 || l == 0 {
+		return nil, parser.NoChildren
+	}
+	typ := ast.DirectiveLeaf
+	if fence > 2 {
+		typ = ast.DirectiveContainer
+	}
+	node := ast.NewDirective(typ, append([]byte{}, line[i:i+l]...))
+	i += l
+
+	hasLabel := false
+	var label text.Segment
+	if i < len(line) && line[i] == '[' {
+		end := findDirectiveLabelEnd(line, i)
+		if end < 0 {
+			return nil, parser.NoChildren
+		}
+		hasLabel = true
+		label = text.NewSegment(segment.Start+i+1-segment.Padding, segment.Start+end-segment.Padding)
+		i = end + 1
+	}
+
+	savedLine, savedPosition := reader.Position()
+	reader.Advance(i - segment.Padding)
+	if !parseDirectiveAttributes(node, reader) {
+		reader.SetPosition(savedLine, savedPosition)
+		return nil, parser.NoChildren
+	}
+	if rest, _ := reader.PeekLine(); !util.IsBlank(rest) {
+		reader.SetPosition(savedLine, savedPosition)
+		return nil, parser.NoChildren
+	}
+	reader.AdvanceToEOL()
+
+	if typ == ast.DirectiveLeaf {
+		node.HasLabel = hasLabel
+		if hasLabel {
+			node.Lines().Append(label)
+		}
+		return node, parser.NoChildren
+	}
+	node.Fence = fence
+	if hasLabel {
+		l := ast.NewDirectiveLabel()
+		l.Lines().Append(label)
+		node.AppendChild(node, l)
+	}
+	return node, parser.HasChildren
+}
+
+func (b *directiveParser) Continue(node gast.Node, reader text.Reader, pc parser.Context) parser.State {
+	n := node.(*ast.Directive)
+	if n.DirectiveType == ast.DirectiveLeaf {
+		return parser.Close
+	}
+	line, _ := reader.PeekLine()
+	w, pos := util.IndentWidth(line, reader.LineOffset())
+	if w < 4 {
+		i := pos
+		for ; i < len(line) && line[i] == ':'; i++ {
+		}
+		if length := i - pos; length >= n.Fence && util.IsBlank(line[i:]) && !hasOpenDirective(n, length, pc) {
+			reader.AdvanceToEOL()
+			return parser.Close
+		}
+	}
+	return parser.Continue | parser.HasChildren
+}
+
+// hasOpenDirective returns true if a container directive opened inside the
+// given directive can be closed by a line of the given number of colons, so
+// that the line closes that directive instead.
+func hasOpenDirective(node *ast.Directive, length int, pc parser.Context) bool {
+	inside := false
+	for _, b := range pc.OpenedBlocks() {
+		if b.Node == node {
+			inside = true
+			continue
+		}
+		if d, ok := b.Node.(*ast.Directive); inside && ok && d.DirectiveType == ast.DirectiveContainer && d.Fence <= length {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *directiveParser) Close(node gast.Node, reader text.Reader, pc parser.Context) {
+	// nothing to do
+}
+
+func (b *directiveParser) CanInterruptParagraph() bool {
+	return true
+}
+
+func (b *directiveParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+// A DirectiveRegistry maps directive names to functions that render them.
+// Directives that are not registered are rendered by fallbacks.
+type DirectiveRegistry struct {
+	html     map[string]renderer.NodeRendererFunc
+	markdown map[string]renderer.NodeRendererFunc
+}
+
+// NewDirectiveRegistry returns a new empty DirectiveRegistry.
+func NewDirectiveRegistry() *DirectiveRegistry {
+	return &DirectiveRegistry{
+		html:     map[string]renderer.NodeRendererFunc{},
+		markdown: map[string]renderer.NodeRendererFunc{},
+	}
+}
+
+// RegisterHTML registers a function that renders directives with the given
+// name as HTML. The function is called with *ast.Directive nodes.
+func (r *DirectiveRegistry) RegisterHTML(name string, f renderer.NodeRendererFunc) {
+	r.html[name] = f
+}
+
+// RegisterMarkdown registers a function that renders directives with the
+// given name as Markdown. The function is called with *ast.Directive nodes.
+func (r *DirectiveRegistry) RegisterMarkdown(name string, f renderer.NodeRendererFunc) {
+	r.markdown[name] = f
+}
+
+func (r *DirectiveRegistry) htmlFunc(name []byte) renderer.NodeRendererFunc {
+	if r == nil {
+		return nil
+	}
+	return r.html[string(name)]
+}
+
+func (r *DirectiveRegistry) markdownFunc(name []byte) renderer.NodeRendererFunc {
+	if r == nil {
+		return nil
+	}
+	return r.markdown[string(name)]
+}
+
+// DirectiveHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Directive nodes with the HTML functions of a DirectiveRegistry.
+//
+// Directives that are not registered are rendered as '<span>' (inline) or
+// '<div>' (leaf and container) elements with the attributes of the
+// directive. Labels of container directives are rendered as paragraphs.
+type DirectiveHTMLRenderer struct {
+	html.Config
+	registry *DirectiveRegistry
+}
+
+// NewDirectiveHTMLRenderer returns a new DirectiveHTMLRenderer that uses
+// the given registry, which may be nil.
+func NewDirectiveHTMLRenderer(registry *DirectiveRegistry, opts ...html.Option) renderer.NodeRenderer {
+	r := &DirectiveHTMLRenderer{
+		Config:   html.NewConfig(),
+		registry: registry,
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *DirectiveHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindDirective, r.renderDirective)
+	reg.Register(ast.KindDirectiveLabel, r.renderDirectiveLabel)
+}
+
+func (r *DirectiveHTMLRenderer) renderDirective(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	n := node.(*ast.Directive)
+	if f := r.registry.htmlFunc(n.Name); f != nil {
+		return f(w, source, n, entering)
+	}
+
+	tag := "div"
+	if n.DirectiveType == ast.DirectiveInline {
+		tag = "span"
+	}
+	if !entering {
+		_, _ = w.WriteString("</" + tag + ">")
+		if n.DirectiveType != ast.DirectiveInline {
+			_ = w.WriteByte('\n')
+		}
+		return gast.WalkContinue, nil
+	}
+	_, _ = w.WriteString("<" + tag)
+	if n.Attributes() != nil {
+		html.RenderAttributes(w, n, html.GlobalAttributeFilter)
+	}
+	_ = w.WriteByte('>')
+	if n.DirectiveType == ast.DirectiveContainer {
+		_ = w.WriteByte('\n')
+	}
+	return gast.WalkContinue, nil
+}
+
+func (r *DirectiveHTMLRenderer) renderDirectiveLabel(
+	w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if entering {
+		_, _ = w.WriteString("<p>")
+	} else {
+		_, _ = w.WriteString("</p>\n")
+	}
+	return gast.WalkContinue, nil
+}
+
+// DirectiveMarkdownRenderer is a renderer.NodeRenderer implementation that
+// writes Directive nodes back to Markdown using a markdown.Renderer, or
+// renders them with the Markdown functions of a DirectiveRegistry.
+type DirectiveMarkdownRenderer struct {
+	*markdown.Renderer
+	registry *DirectiveRegistry
+}
+
+// NewDirectiveMarkdownRenderer returns a new DirectiveMarkdownRenderer that
+// writes through the given markdown.Renderer and uses the given registry,
+// which may be nil.
+func NewDirectiveMarkdownRenderer(r *markdown.Renderer, registry *DirectiveRegistry) renderer.NodeRenderer {
+	return &DirectiveMarkdownRenderer{r, registry}
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *DirectiveMarkdownRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindDirective, r.renderDirective)
+	reg.Register(ast.KindDirectiveLabel, r.renderDirectiveLabel)
+}
+
+func (r *DirectiveMarkdownRenderer) renderDirective(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	n := node.(*ast.Directive)
+	if f := r.registry.markdownFunc(n.Name); f != nil {
+		return f(w, source, n, entering)
+	}
+
+	if !entering {
+		var err error
+		switch n.DirectiveType {
+		case ast.DirectiveContainer:
+			_, err = r.Write(w, append(bytes.Repeat([]byte{':'}, n.Fence), '\n'))
+		default:
+			if n.HasLabel {
+				err = r.WriteByte(w, ']')
+			}
+			if err == nil {
+				err = r.WriteAttributes(w, n.Attributes())
+			}
+		}
+		if err == nil && n.DirectiveType != ast.DirectiveInline {
+			err = r.CloseBlock(w)
+		}
+		if err != nil {
+			return gast.WalkStop, err
+		}
+		return gast.WalkContinue, nil
+	}
+
+	if n.DirectiveType != ast.DirectiveInline {
+		if err := r.OpenBlock(w, source, n); err != nil {
+			return gast.WalkStop, err
+		}
+	}
+	var buf bytes.Buffer
+	switch n.DirectiveType {
+	case ast.DirectiveInline:
+		buf.WriteByte(':')
+	case ast.DirectiveLeaf:
+		buf.WriteString("::")
+	case ast.DirectiveContainer:
+		buf.Write(bytes.Repeat([]byte{':'}, n.Fence))
+	}
+	buf.Write(n.Name)
+	if n.HasLabel {
+		buf.WriteByte('[')
+	}
+	if _, err := r.Write(w, buf.Bytes()); err != nil {
+		return gast.WalkStop, err
+	}
+	if n.DirectiveType == ast.DirectiveContainer && (n.FirstChild() == nil || n.FirstChild().Kind() != ast.KindDirectiveLabel) {
+		if err := r.WriteAttributes(w, n.Attributes()); err != nil {
+			return gast.WalkStop, err
+		}
+		if err := r.WriteByte(w, '\n'); err != nil {
+			return gast.WalkStop, err
+		}
+	}
+	return gast.WalkContinue, nil
+}
+
+func (r *DirectiveMarkdownRenderer) renderDirectiveLabel(
+	w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if entering {
+		if err := r.WriteByte(w, '['); err != nil {
+			return gast.WalkStop, err
+		}
+		return gast.WalkContinue, nil
+	}
+	if err := r.WriteByte(w, ']'); err != nil {
+		return gast.WalkStop, err
+	}
+	if err := r.WriteAttributes(w, n.Parent().Attributes()); err != nil {
+		return gast.WalkStop, err
+	}
+	if err := r.WriteByte(w, '\n'); err != nil {
+		return gast.WalkStop, err
+	}
+	return gast.WalkContinue, nil
+}
+
+type directives struct {
+	registry *DirectiveRegistry
+}
+
+// Directive is an extension that parses generic directives like
+// ':abbr[HTML]{title="HyperText Markup Language"}', '::youtube[Video]{#id}'
+// and ':::warning' blocks into Directive nodes, and renders them with the
+// fallbacks of DirectiveHTMLRenderer.
+var Directive = NewDirective(nil)
+
+// NewDirective returns a new extension that renders directives with the
+// given registry, which may be nil.
+func NewDirective(registry *DirectiveRegistry) goldmark.Extender {
+	return &directives{
+		registry: registry,
+	}
+}
+
+func (e *directives) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(
+			util.Prioritized(NewDirectiveParser(), 720),
+		),
+		parser.WithInlineParsers(
+			util.Prioritized(NewInlineDirectiveParser(m.Parser()), 150),
+		),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewDirectiveHTMLRenderer(e.registry), 500),
+	))
+}
@@ -0,0 +1,101 @@
+package extension
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pgavlin/goldmark"
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/extension/ast"
+	"github.com/pgavlin/goldmark/renderer"
+	"github.com/pgavlin/goldmark/renderer/markdown"
+	"github.com/pgavlin/goldmark/testutil"
+	"github.com/pgavlin/goldmark/text"
+	"github.com/pgavlin/goldmark/util"
+)
+
+func TestDirective(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			Directive,
+		),
+	)
+	testutil.DoTestCaseFile(markdown, "_test/directive.txt", t, testutil.ParseCliCaseArg()...)
+}
+
+func TestDirectiveRegistry(t *testing.T) {
+	registry := NewDirectiveRegistry()
+	registry.RegisterHTML("youtube", func(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if entering {
+			id, _ := n.AttributeString("id")
+			_, _ = w.WriteString(`<iframe src="https://www.youtube.com/embed/`)
+			_, _ = w.Write(id.([]byte))
+			_, _ = w.WriteString("\"></iframe>\n")
+		}
+		return gast.WalkSkipChildren, nil
+	})
+	md := goldmark.New(goldmark.WithExtensions(NewDirective(registry)))
+
+	var buf bytes.Buffer
+	if err := md.Convert([]byte("::youtube[Video]{#abc}\n::other\n"), &buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := "<iframe src=\"https://www.youtube.com/embed/abc\"></iframe>\n<div></div>\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestDirectiveFootnotes(t *testing.T) {
+	md := goldmark.New(goldmark.WithExtensions(Directive, NewFootnote(WithInlineFootnotes())))
+
+	var buf bytes.Buffer
+	if err := md.Convert([]byte(":span[x^[n]]\n"), &buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := `<p><span>x<sup id="fnref:1"><a href="#fn:1" class="footnote-ref" role="doc-noteref">1</a></sup></span></p>
+<div class="footnotes" role="doc-endnotes">
+<hr>
+<ol>
+<li id="fn:1">
+<p>n&#160;<a href="#fnref:1" class="footnote-backref" role="doc-backlink">&#x21a9;&#xfe0e;</a></p>
+</li>
+</ol>
+</div>
+`
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+// TestDirectiveRegistryMarkdown checks that registered functions replace
+// the fallback of the Markdown renderer.
+func TestDirectiveRegistryMarkdown(t *testing.T) {
+	md := goldmark.New(goldmark.WithExtensions(Directive))
+	registry := NewDirectiveRegistry()
+	source := []byte("A :kbd[Ctrl] key.\n")
+	doc := md.Parser().Parse(text.NewReader(source))
+	mr := &markdown.Renderer{}
+	registry.RegisterMarkdown("kbd", func(w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if entering {
+			_, _ = mr.WriteString(w, "<kbd>")
+		} else {
+			_, _ = mr.WriteString(w, "</kbd>")
+		}
+		return gast.WalkContinue, nil
+	})
+	r := renderer.NewRenderer(renderer.WithNodeRenderers(
+		util.Prioritized(mr, 100),
+		util.Prioritized(NewDirectiveMarkdownRenderer(mr, registry), 100),
+	))
+	var buf bytes.Buffer
+	if err := r.Render(&buf, source, doc); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "A <kbd>Ctrl</kbd> key.\n"; buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+	if d := doc.FirstChild().FirstChild().NextSibling(); d.Kind() != ast.KindDirective {
+		t.Errorf("expected a directive, got %s", d.Kind())
+	}
+}
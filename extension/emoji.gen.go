@@ -0,0 +1,7 @@
+// Code generated by _tools; DO NOT EDIT.
+package extension
+const _emojiLength = 1913
+const _emojiName string = "grinningsmileysmilegrinlaughingsatisfiedsweat_smilerofljoyslightly_smiling_faceupside_down_facemelting_facewinkblushinnocentsmiling_face_with_three_heartsheart_eyesstar_struckkissing_heartkissingrelaxedkissing_closed_eyeskissing_smiling_eyessmiling_face_with_tearyumstuck_out_tonguestuck_out_tongue_winking_eyezany_facestuck_out_tongue_closed_eyesmoney_mouth_facehugshand_over_mouthface_with_open_eyes_and_hand_over_mouthface_with_peeking_eyeshushing_facethinkingsaluting_facezipper_mouth_faceraised_eyebrowneutral_faceexpressionlessno_mouthdotted_line_faceface_in_cloudssmirkunamusedroll_eyesgrimacingface_exhalinglying_faceshaking_facerelievedpensivesleepydrooling_facesleepingmaskface_with_thermometerface_with_head_bandagenauseated_facevomiting_facesneezing_facehot_facecold_facewoozy_facedizzy_faceface_with_spiral_eyesexploding_headcowboy_hat_facepartying_facedisguised_facesunglassesnerd_facemonocle_faceconfusedface_with_diagonal_mouthworriedslightly_frowning_facefrowning_faceopen_mouthhushedastonishedflushedpleading_faceface_holding_back_tearsfrowninganguishedfearfulcold_sweatdisappointed_relievedcrysobscreamconfoundedperseveredisappointedsweatwearytired_faceyawning_facetriumphragepoutangrycursing_facesmiling_impimpskullskull_and_crossboneshankeypoopshitclown_facejapanese_ogrejapanese_goblinghostalienspace_invaderrobotsmiley_catsmile_catjoy_catheart_eyes_catsmirk_catkissing_catscream_catcrying_cat_facepouting_catsee_no_evilhear_no_evilspeak_no_evillove_lettercupidgift_heartsparkling_heartheartpulseheartbeatrevolving_heartstwo_heartsheart_decorationheavy_heart_exclamationbroken_heartheart_on_firemending_heartheartpink_heartorange_heartyellow_heartgreen_heartblue_heartlight_blue_heartpurple_heartbrown_heartblack_heartgrey_heartwhite_heartkiss100angerboomcollisiondizzysweat_dropsdashholespeech_ballooneye_speech_bubbleleft_speech_bubbleright_anger_bubblethought_balloonzzzwaveraised_back_of_handraised_hand_with_fingers_splayedhandraised_handvulcan_saluterightwards_handleftwards_handpalm_down_handpalm_up_handleftwards_pushing_handrightwards_pushing_handok_handpinched_fingerspinching_handvcrossed_fingershand_with_index_finger_and_thumb_crossedlove_you_gesturemetalcall_me_handpoint_leftpoint_rightpoint_up_2middle_fingerfupoint_downpoint_upindex_pointing_at_the_viewer+1thumbsup-1thumbsdownfist_raisedfistfist_oncomingfacepunchpunchfist_leftfist_rightclapraised_handsheart_handsopen_handspalms_up_togetherhandshakepraywriting_handnail_careselfiemusclemechanical_armmechanical_leglegfootearear_with_hearing_aidnosebrainanatomical_heartlungstoothboneeyeseyetonguelipsbiting_lipbabychildboygirladultblond_haired_personmanbearded_personman_beardwoman_beardred_haired_mancurly_haired_manwhite_haired_manbald_manwomanred_haired_womanperson_red_haircurly_haired_womanperson_curly_hairwhite_haired_womanperson_white_hairbald_womanperson_baldblond_haired_womanblonde_womanblond_haired_manolder_adultolder_manolder_womanfrowning_personfrowning_manfrowning_womanpouting_facepouting_manpouting_womanno_goodno_good_manng_manno_good_womanng_womanok_personok_manok_womantipping_hand_personinformation_desk_persontipping_hand_mansassy_mantipping_hand_womansassy_womanraising_handraising_hand_manraising_hand_womandeaf_persondeaf_mandeaf_womanbowbowing_manbowing_womanfacepalmman_facepalmingwoman_facepalmingshrugman_shruggingwoman_shrugginghealth_workerman_health_workerwoman_health_workerstudentman_studentwoman_studentteacherman_teacherwoman_teacherjudgeman_judgewoman_judgefarmerman_farmerwoman_farmercookman_cookwoman_cookmechanicman_mechanicwoman_mechanicfactory_workerman_factory_workerwoman_factory_workeroffice_workerman_office_workerwoman_office_workerscientistman_scientistwoman_scientisttechnologistman_technologistwoman_technologistsingerman_singerwoman_singerartistman_artistwoman_artistpilotman_pilotwoman_pilotastronautman_astronautwoman_astronautfirefighterman_firefighterwoman_firefighterpolice_officercoppolicemanpolicewomandetectivemale_detectivefemale_detectiveguardguardsmanguardswomanninjaconstruction_workerconstruction_worker_manconstruction_worker_womanperson_with_crownprinceprincessperson_with_turbanman_with_turbanwoman_with_turbanman_with_gua_pi_maowoman_with_headscarfperson_in_tuxedoman_in_tuxedowoman_in_tuxedoperson_with_veilman_with_veilwoman_with_veilbride_with_veilpregnant_womanpregnant_manpregnant_personbreast_feedingwoman_feeding_babyman_feeding_babyperson_feeding_babyangelsantamrs_clausmx_claussuperherosuperhero_mansuperhero_womansupervillainsupervillain_mansupervillain_womanmagemage_manmage_womanfairyfairy_manfairy_womanvampirevampire_manvampire_womanmerpersonmermanmermaidelfelf_manelf_womangeniegenie_mangenie_womanzombiezombie_manzombie_womantrollmassagemassage_manmassage_womanhaircuthaircut_manhaircut_womanwalkingwalking_manwalking_womanstanding_personstanding_manstanding_womankneeling_personkneeling_mankneeling_womanperson_with_probing_caneman_with_probing_canewoman_with_probing_caneperson_in_motorized_wheelchairman_in_motorized_wheelchairwoman_in_motorized_wheelchairperson_in_manual_wheelchairman_in_manual_wheelchairwoman_in_manual_wheelchairrunnerrunningrunning_manrunning_womanwoman_dancingdancerman_dancingbusiness_suit_levitatingdancersdancing_mendancing_womensauna_personsauna_mansauna_womanclimbingclimbing_manclimbing_womanperson_fencinghorse_racingskiersnowboardergolfinggolfing_mangolfing_womansurfersurfing_mansurfing_womanrowboatrowing_manrowing_womanswimmerswimming_manswimming_womanbouncing_ball_personbouncing_ball_manbasketball_manbouncing_ball_womanbasketball_womanweight_liftingweight_lifting_manweight_lifting_womanbicyclistbiking_manbiking_womanmountain_bicyclistmountain_biking_manmountain_biking_womancartwheelingman_cartwheelingwoman_cartwheelingwrestlingmen_wrestlingwomen_wrestlingwater_poloman_playing_water_polowoman_playing_water_polohandball_personman_playing_handballwoman_playing_handballjuggling_personman_jugglingwoman_jugglinglotus_positionlotus_position_manlotus_position_womanbathsleeping_bedpeople_holding_handstwo_women_holding_handscoupletwo_men_holding_handscouplekisscouplekiss_man_womancouplekiss_man_mancouplekiss_woman_womancouple_with_heartcouple_with_heart_woman_mancouple_with_heart_man_mancouple_with_heart_woman_womanfamilyfamily_man_woman_boyfamily_man_woman_girlfamily_man_woman_girl_boyfamily_man_woman_boy_boyfamily_man_woman_girl_girlfamily_man_man_boyfamily_man_man_girlfamily_man_man_girl_boyfamily_man_man_boy_boyfamily_man_man_girl_girlfamily_woman_woman_boyfamily_woman_woman_girlfamily_woman_woman_girl_boyfamily_woman_woman_boy_boyfamily_woman_woman_girl_girlfamily_man_boyfamily_man_boy_boyfamily_man_girlfamily_man_girl_boyfamily_man_girl_girlfamily_woman_boyfamily_woman_boy_boyfamily_woman_girlfamily_woman_girl_boyfamily_woman_girl_girlspeaking_headbust_in_silhouettebusts_in_silhouettepeople_huggingfootprintsmonkey_facemonkeygorillaorangutandogdog2guide_dogservice_dogpoodlewolffox_faceraccooncatcat2black_catliontigertiger2leopardhorsemoosedonkeyracehorseunicornzebradeerbisoncowoxwater_buffalocow2pigpig2boarpig_noseramsheepgoatdromedary_camelcamelllamagiraffeelephantmammothrhinoceroshippopotamusmousemouse2rathamsterrabbitrabbit2chipmunkbeaverhedgehogbatbearpolar_bearkoalapanda_faceslothotterskunkkangaroobadgerfeetpaw_printsturkeychickenroosterhatching_chickbaby_chickhatched_chickbirdpenguindoveeagleduckswanowldodofeatherflamingopeacockparrotwingblack_birdgoosefrogcrocodileturtlelizardsnakedragon_facedragonsauropodt-rexwhalewhale2dolphinflippersealfishtropical_fishblowfishsharkoctopusshellcoraljellyfishsnailbutterflybugantbeehoneybeebeetlelady_beetlecricketcockroachspiderspider_webscorpionmosquitoflywormmicrobebouquetcherry_blossomwhite_flowerlotusrosetterosewilted_flowerhibiscussunflowerblossomtuliphyacinthseedlingpotted_plantevergreen_treedeciduous_treepalm_treecactusear_of_riceherbshamrockfour_leaf_clovermaple_leaffallen_leafleavesempty_nestnest_with_eggsmushroomgrapesmelonwatermelontangerineorangemandarinlemonbananapineapplemangoapplegreen_applepearpeachcherriesstrawberryblueberrieskiwi_fruittomatoolivecoconutavocadoeggplantpotatocarrotcornhot_pepperbell_peppercucumberleafy_greenbroccoligarliconionpeanutsbeanschestnutginger_rootpea_podbreadcroissantbaguette_breadflatbreadpretzelbagelpancakeswafflecheesemeat_on_bonepoultry_legcut_of_meatbaconhamburgerfriespizzahotdogsandwichtacoburritotamalestuffed_flatbreadfalafeleggfried_eggshallow_pan_of_foodstewfonduebowl_with_spoongreen_saladpopcornbuttersaltcanned_foodbentorice_crackerrice_ballricecurryramenspaghettisweet_potatoodensushifried_shrimpfish_cakemoon_cakedangodumplingfortune_cookietakeout_boxcrablobstershrimpsquidoystericecreamshaved_iceice_creamdoughnutcookiebirthdaycakecupcakepiechocolate_barcandylollipopcustardhoney_potbaby_bottlemilk_glasscoffeeteapotteasakechampagnewine_glasscocktailtropical_drinkbeerbeersclinking_glassestumbler_glasspouring_liquidcup_with_strawbubble_teabeverage_boxmateice_cubechopsticksplate_with_cutleryfork_and_knifespoonhochoknifejaramphoraearth_africaearth_americasearth_asiaglobe_with_meridiansworld_mapjapancompassmountain_snowmountainvolcanomount_fujicampingbeach_umbrelladesertdesert_islandnational_parkstadiumclassical_buildingbuilding_constructionbricksrockwoodhuthousesderelict_househousehouse_with_gardenofficepost_officeeuropean_post_officehospitalbankhotellove_hotelconvenience_storeschooldepartment_storefactoryjapanese_castleeuropean_castleweddingtokyo_towerstatue_of_libertychurchmosquehindu_templesynagogueshinto_shrinekaabafountaintentfoggynight_with_starscityscapesunrise_over_mountainssunrisecity_sunsetcity_sunrisebridge_at_nighthotspringscarousel_horseplayground_slideferris_wheelroller_coasterbarbercircus_tentsteam_locomotiverailway_carbullettrain_sidebullettrain_fronttrain2metrolight_railstationtrammonorailmountain_railwaytrainbusoncoming_bustrolleybusminibusambulancefire_enginepolice_caroncoming_police_cartaxioncoming_taxicarred_caroncoming_automobileblue_carpickup_trucktruckarticulated_lorrytractorracing_carmotorcyclemotor_scootermanual_wheelchairmotorized_wheelchairauto_rickshawbikekick_scooterskateboardroller_skatebusstopmotorwayrailway_trackoil_drumfuelpumpwheelrotating_lighttraffic_lightvertical_traffic_lightstop_signconstructionanchorring_buoyboatsailboatcanoespeedboatpassenger_shipferrymotor_boatshipairplanesmall_airplaneflight_departureflight_arrivalparachuteseathelicoptersuspension_railwaymountain_cablewayaerial_tramwayartificial_satelliterocketflying_saucerbellhop_bellluggagehourglasshourglass_flowing_sandwatchalarm_clockstopwatchtimer_clockmantelpiece_clockclock12clock1230clock1clock130clock2clock230clock3clock330clock4clock430clock5clock530clock6clock630clock7clock730clock8clock830clock9clock930clock10clock1030clock11clock1130new_moonwaxing_crescent_moonfirst_quarter_moonmoonwaxing_gibbous_moonfull_moonwaning_gibbous_moonlast_quarter_moonwaning_crescent_mooncrescent_moonnew_moon_with_facefirst_quarter_moon_with_facelast_quarter_moon_with_facethermometersunnyfull_moon_with_facesun_with_faceringed_planetstarstar2starsmilky_waycloudpartly_sunnycloud_with_lightning_and_rainsun_behind_small_cloudsun_behind_large_cloudsun_behind_rain_cloudcloud_with_raincloud_with_snowcloud_with_lightningtornadofogwind_facecyclonerainbowclosed_umbrellaopen_umbrellaumbrellaparasol_on_groundzapsnowflakesnowman_with_snowsnowmancometfiredropletoceanjack_o_lanternchristmas_treefireworkssparklerfirecrackersparklesballoontadaconfetti_balltanabata_treebamboodollsflagswind_chimerice_scenered_enveloperibbongiftreminder_ribbonticketsticketmedal_militarytrophymedal_sports1st_place_medal2nd_place_medal3rd_place_medalsoccerbaseballsoftballbasketballvolleyballfootballrugby_footballtennisflying_discbowlingcricket_gamefield_hockeyice_hockeylacrosseping_pongbadmintonboxing_glovemartial_arts_uniformgoal_netgolfice_skatefishing_pole_and_fishdiving_maskrunning_shirt_with_sashskisledcurling_stonedartyo_yokitegun8ballcrystal_ballmagic_wandvideo_gamejoystickslot_machinegame_diejigsawteddy_bearpinatamirror_ballnesting_dollsspadesheartsdiamondsclubschess_pawnblack_jokermahjongflower_playing_cardsperforming_artsframed_pictureartthreadsewing_needleyarnknoteyeglassesdark_sunglassesgoggleslab_coatsafety_vestnecktieshirttshirtjeansscarfglovescoatsocksdresskimonosarione_piece_swimsuitswim_briefshortsbikiniwomans_clothesfolding_hand_fanpursehandbagpouchshoppingschool_satchelthong_sandalmans_shoeshoeathletic_shoehiking_bootflat_shoehigh_heelsandalballet_shoesboothair_pickcrownwomans_hattophatmortar_boardbilled_capmilitary_helmetrescue_worker_helmetprayer_beadslipstickringgemmutespeakersoundloud_soundloudspeakermegapostal_hornbellno_bellmusical_scoremusical_notenotesstudio_microphonelevel_slidercontrol_knobsmicrophoneheadphonesradiosaxophoneaccordionguitarmusical_keyboardtrumpetviolinbanjodrumlong_drummaracasfluteiphonecallingphonetelephonetelephone_receiverpagerfaxbatterylow_batteryelectric_plugcomputerdesktop_computerprinterkeyboardcomputer_mousetrackballminidiscfloppy_diskcddvdabacusmovie_camerafilm_stripfilm_projectorclappertvcameracamera_flashvideo_cameravhsmagmag_rightcandlebulbflashlightizakaya_lanternlanterndiya_lampnotebook_with_decorative_coverclosed_bookbookopen_bookgreen_bookblue_bookorange_bookbooksnotebookledgerpage_with_curlscrollpage_facing_upnewspapernewspaper_rollbookmark_tabsbookmarklabelmoneybagcoinyendollareuropoundmoney_with_wingscredit_cardreceiptchartenvelopeemaile-mailincoming_envelopeenvelope_with_arrowoutbox_trayinbox_traypackagemailboxmailbox_closedmailbox_with_mailmailbox_with_no_mailpostboxballot_boxpencil2black_nibfountain_penpenpaintbrushcrayonmemopencilbriefcasefile_folderopen_file_foldercard_index_dividersdatecalendarspiral_notepadspiral_calendarcard_indexchart_with_upwards_trendchart_with_downwards_trendbar_chartclipboardpushpinround_pushpinpaperclippaperclipsstraight_rulertriangular_rulerscissorscard_file_boxfile_cabinetwastebasketlockunlocklock_with_ink_penclosed_lock_with_keykeyold_keyhammeraxepickhammer_and_pickhammer_and_wrenchdaggercrossed_swordsbombboomerangbow_and_arrowshieldcarpentry_sawwrenchscrewdrivernut_and_boltgearclampbalance_scaleprobing_canelinkchainshooktoolboxmagnetladderalembictest_tubepetri_dishdnamicroscopetelescopesatellitesyringedrop_of_bloodpilladhesive_bandagecrutchstethoscopex_raydoorelevatormirrorwindowbedcouch_and_lampchairtoiletplungershowerbathtubmouse_traprazorlotion_bottlesafety_pinbroombasketroll_of_paperbucketsoapbubblestoothbrushspongefire_extinguishershopping_cartsmokingcoffinheadstonefuneral_urnnazar_amulethamsamoyaiplacardidentification_cardatmput_litter_in_its_placepotable_waterwheelchairmenswomensrestroombaby_symbolwcpassport_controlcustomsbaggage_claimleft_luggagewarningchildren_crossingno_entryno_entry_signno_bicyclesno_smokingdo_not_litternon-potable_waterno_pedestriansno_mobile_phonesunderageradioactivebiohazardarrow_uparrow_upper_rightarrow_rightarrow_lower_rightarrow_downarrow_lower_leftarrow_leftarrow_upper_leftarrow_up_downleft_right_arrowleftwards_arrow_with_hookarrow_right_hookarrow_heading_uparrow_heading_downarrows_clockwisearrows_counterclockwisebackendonsoontopplace_of_worshipatom_symbolomstar_of_davidwheel_of_dharmayin_yanglatin_crossorthodox_crossstar_and_crescentpeace_symbolmenorahsix_pointed_starkhandaariestaurusgeminicancerleovirgolibrascorpiussagittariuscapricornaquariuspiscesophiuchustwisted_rightwards_arrowsrepeatrepeat_onearrow_forwardfast_forwardnext_track_buttonplay_or_pause_buttonarrow_backwardrewindprevious_track_buttonarrow_up_smallarrow_double_uparrow_down_smallarrow_double_downpause_buttonstop_buttonrecord_buttoneject_buttoncinemalow_brightnesshigh_brightnesssignal_strengthwirelessvibration_modemobile_phone_offfemale_signmale_signtransgender_symbolheavy_multiplication_xheavy_plus_signheavy_minus_signheavy_division_signheavy_equals_signinfinitybangbanginterrobangquestiongrey_questiongrey_exclamationexclamationheavy_exclamation_markwavy_dashcurrency_exchangeheavy_dollar_signmedical_symbolrecyclefleur_de_listridentname_badgebeginnerowhite_check_markballot_box_with_checkheavy_check_markxnegative_squared_cross_markcurly_looplooppart_alternation_markeight_spoked_asteriskeight_pointed_black_starsparklecopyrightregisteredtmhashasteriskzeroonetwothreefourfivesixseveneightninekeycap_tencapital_abcdabcd1234symbolsabcaabbclcoolfreeinformation_sourceidmnewngo2okparkingsosupvskokosau6708u6709u6307ideograph_advantageu5272u7121u7981acceptu7533u5408u7a7acongratulationssecretu55b6u6e80red_circleorange_circleyellow_circlegreen_circlelarge_blue_circlepurple_circlebrown_circleblack_circlewhite_circlered_squareorange_squareyellow_squaregreen_squareblue_squarepurple_squarebrown_squareblack_large_squarewhite_large_squareblack_medium_squarewhite_medium_squareblack_medium_small_squarewhite_medium_small_squareblack_small_squarewhite_small_squarelarge_orange_diamondlarge_blue_diamondsmall_orange_diamondsmall_blue_diamondsmall_red_trianglesmall_red_triangle_downdiamond_shape_with_a_dot_insideradio_buttonwhite_square_buttonblack_square_buttoncheckered_flagtriangular_flag_on_postcrossed_flagsblack_flagwhite_flagrainbow_flagtransgender_flagpirate_flagascension_islandandorraunited_arab_emiratesafghanistanantigua_barbudaanguillaalbaniaarmeniaangolaantarcticaargentinaamerican_samoaaustriaaustraliaarubaaland_islandsazerbaijanbosnia_herzegovinabarbadosbangladeshbelgiumburkina_fasobulgariabahrainburundibeninst_barthelemybermudabruneiboliviacaribbean_netherlandsbrazilbahamasbhutanbouvet_islandbotswanabelarusbelizecanadacocos_islandscongo_kinshasacentral_african_republiccongo_brazzavilleswitzerlandcote_divoirecook_islandschilecamerooncncolombiaclipperton_islandcosta_ricacubacape_verdecuracaochristmas_islandcyprusczech_republicdediego_garciadjiboutidenmarkdominicadominican_republicalgeriaceuta_melillaecuadorestoniaegyptwestern_saharaeritreaesethiopiaeueuropean_unionfinlandfijifalkland_islandsmicronesiafaroe_islandsfrgabongbukgrenadageorgiafrench_guianaguernseyghanagibraltargreenlandgambiaguineaguadeloupeequatorial_guineagreecesouth_georgia_south_sandwich_islandsguatemalaguamguinea_bissauguyanahong_kongheard_mcdonald_islandshondurascroatiahaitihungarycanary_islandsindonesiairelandisraelisle_of_manindiabritish_indian_ocean_territoryiraqiranicelanditjerseyjamaicajordanjpkenyakyrgyzstancambodiakiribaticomorosst_kitts_nevisnorth_koreakrkuwaitcayman_islandskazakhstanlaoslebanonst_lucialiechtensteinsri_lankaliberialesotholithuanialuxembourglatvialibyamoroccomonacomoldovamontenegrost_martinmadagascarmarshall_islandsmacedoniamalimyanmarmongoliamacaunorthern_mariana_islandsmartiniquemauritaniamontserratmaltamauritiusmaldivesmalawimexicomalaysiamozambiquenamibianew_caledonianigernorfolk_islandnigerianicaraguanetherlandsnorwaynepalnauruniuenew_zealandomanpanamaperufrench_polynesiapapua_new_guineaphilippinespakistanpolandst_pierre_miquelonpitcairn_islandspuerto_ricopalestinian_territoriesportugalpalauparaguayqatarreunionromaniaserbiarurwandasaudi_arabiasolomon_islandsseychellessudanswedensingaporest_helenasloveniasvalbard_jan_mayenslovakiasierra_leonesan_marinosenegalsomaliasurinamesouth_sudansao_tome_principeel_salvadorsint_maartensyriaswazilandtristan_da_cunhaturks_caicos_islandschadfrench_southern_territoriestogothailandtajikistantokelautimor_lesteturkmenistantunisiatongatrtrinidad_tobagotuvalutaiwantanzaniaukraineugandaus_outlying_islandsunited_nationsusuruguayuzbekistanvatican_cityst_vincent_grenadinesvenezuelabritish_virgin_islandsus_virgin_islandsvietnamvanuatuwallis_futunasamoakosovoyemenmayottesouth_africazambiazimbabweenglandscotlandwales"
+const _emojiNameIndex  = "\x08\x06\x05\x04\x08\x09\x0b\x04\x03\x15\x10\x0c\x04\x05\x08\x1e\x0a\x0b\x0d\x07\x07\x13\x14\x16\x03\x10\x1c\x09\x1c\x10\x04\x0f\x27\x15\x0d\x08\x0d\x11\x0e\x0c\x0e\x08\x10\x0e\x05\x08\x09\x09\x0d\x0a\x0c\x08\x07\x06\x0d\x08\x04\x15\x16\x0e\x0d\x0d\x08\x09\x0a\x0a\x15\x0e\x0f\x0d\x0e\x0a\x09\x0c\x08\x18\x07\x16\x0d\x0a\x06\x0a\x07\x0d\x17\x08\x09\x07\x0a\x15\x03\x03\x06\x0a\x09\x0c\x05\x05\x0a\x0c\x07\x04\x04\x05\x0c\x0b\x03\x05\x14\x06\x04\x04\x0a\x0d\x0f\x05\x05\x0d\x05\x0a\x09\x07\x0e\x09\x0b\x0a\x0f\x0b\x0b\x0c\x0d\x0b\x05\x0a\x0f\x0a\x09\x10\x0a\x10\x17\x0c\x0d\x0d\x05\x0a\x0c\x0c\x0b\x0a\x10\x0c\x0b\x0b\x0a\x0b\x04\x03\x05\x04\x09\x05\x0b\x04\x04\x0e\x11\x12\x12\x0f\x03\x04\x13\x20\x04\x0b\x0d\x0f\x0e\x0e\x0c\x16\x17\x07\x0f\x0d\x01\x0f\x28\x10\x05\x0c\x0a\x0b\x0a\x0d\x02\x0a\x08\x1c\x02\x08\x02\x0a\x0b\x04\x0d\x09\x05\x09\x0a\x04\x0c\x0b\x0a\x11\x09\x04\x0c\x09\x06\x06\x0e\x0e\x03\x04\x03\x14\x04\x05\x10\x05\x05\x04\x04\x03\x06\x04\x0a\x04\x05\x03\x04\x05\x13\x03\x0e\x09\x0b\x0e\x10\x10\x08\x05\x10\x0f\x12\x11\x12\x11\x0a\x0b\x12\x0c\x10\x0b\x09\x0b\x0f\x0c\x0e\x0c\x0b\x0d\x07\x0b\x06\x0d\x08\x09\x06\x08\x13\x17\x10\x09\x12\x0b\x0c\x10\x12\x0b\x08\x0a\x03\x0a\x0c\x08\x0f\x11\x05\x0d\x0f\x0d\x11\x13\x07\x0b\x0d\x07\x0b\x0d\x05\x09\x0b\x06\x0a\x0c\x04\x08\x0a\x08\x0c\x0e\x0e\x12\x14\x0d\x11\x13\x09\x0d\x0f\x0c\x10\x12\x06\x0a\x0c\x06\x0a\x0c\x05\x09\x0b\x09\x0d\x0f\x0b\x0f\x11\x0e\x03\x09\x0b\x09\x0e\x10\x05\x09\x0b\x05\x13\x17\x19\x11\x06\x08\x12\x0f\x11\x13\x14\x10\x0d\x0f\x10\x0d\x0f\x0f\x0e\x0c\x0f\x0e\x12\x10\x13\x05\x05\x09\x08\x09\x0d\x0f\x0c\x10\x12\x04\x08\x0a\x05\x09\x0b\x07\x0b\x0d\x09\x06\x07\x03\x07\x09\x05\x09\x0b\x06\x0a\x0c\x05\x07\x0b\x0d\x07\x0b\x0d\x07\x0b\x0d\x0f\x0c\x0e\x0f\x0c\x0e\x18\x15\x17\x1e\x1b\x1d\x1b\x18\x1a\x06\x07\x0b\x0d\x0d\x06\x0b\x18\x07\x0b\x0d\x0c\x09\x0b\x08\x0c\x0e\x0e\x0c\x05\x0b\x07\x0b\x0d\x06\x0b\x0d\x07\x0a\x0c\x07\x0c\x0e\x14\x11\x0e\x13\x10\x0e\x12\x14\x09\x0a\x0c\x12\x13\x15\x0c\x10\x12\x09\x0d\x0f\x0a\x16\x18\x0f\x14\x16\x0f\x0c\x0e\x0e\x12\x14\x04\x0c\x14\x17\x06\x15\x0a\x14\x12\x16\x11\x1b\x19\x1d\x06\x14\x15\x19\x18\x1a\x12\x13\x17\x16\x18\x16\x17\x1b\x1a\x1c\x0e\x12\x0f\x13\x14\x10\x14\x11\x15\x16\x0d\x12\x13\x0e\x0a\x0b\x06\x07\x09\x03\x04\x09\x0b\x06\x04\x08\x07\x03\x04\x09\x04\x05\x06\x07\x05\x05\x06\x09\x07\x05\x04\x05\x03\x02\x0d\x04\x03\x04\x04\x08\x03\x05\x04\x0f\x05\x05\x07\x08\x07\x0a\x0c\x05\x06\x03\x07\x06\x07\x08\x06\x08\x03\x04\x0a\x05\x0a\x05\x05\x05\x08\x06\x04\x0a\x06\x07\x07\x0e\x0a\x0d\x04\x07\x04\x05\x04\x04\x03\x04\x07\x08\x07\x06\x04\x0a\x05\x04\x09\x06\x06\x05\x0b\x06\x08\x05\x05\x06\x07\x07\x04\x04\x0d\x08\x05\x07\x05\x05\x09\x05\x09\x03\x03\x03\x08\x06\x0b\x07\x09\x06\x0a\x08\x08\x03\x04\x07\x07\x0e\x0c\x05\x07\x04\x0d\x08\x09\x07\x05\x08\x08\x0c\x0e\x0e\x09\x06\x0b\x04\x08\x10\x0a\x0b\x06\x0a\x0e\x08\x06\x05\x0a\x09\x06\x08\x05\x06\x09\x05\x05\x0b\x04\x05\x08\x0a\x0b\x0a\x06\x05\x07\x07\x08\x06\x06\x04\x0a\x0b\x08\x0b\x08\x06\x05\x07\x05\x08\x0b\x07\x05\x09\x0e\x09\x07\x05\x08\x06\x06\x0c\x0b\x0b\x05\x09\x05\x05\x06\x08\x04\x07\x06\x11\x07\x03\x09\x13\x04\x06\x0f\x0b\x07\x06\x04\x0b\x05\x0c\x09\x04\x05\x05\x09\x0c\x04\x05\x0c\x09\x09\x05\x08\x0e\x0b\x04\x07\x06\x05\x06\x08\x0a\x09\x08\x06\x08\x04\x07\x03\x0d\x05\x08\x07\x09\x0b\x0a\x06\x06\x03\x04\x09\x0a\x08\x0e\x04\x05\x10\x0d\x0e\x0e\x0a\x0c\x04\x08\x0a\x12\x0e\x05\x05\x05\x03\x07\x0c\x0e\x0a\x14\x09\x05\x07\x0d\x08\x07\x0a\x07\x0e\x06\x0d\x0d\x07\x12\x15\x06\x04\x04\x03\x06\x0e\x05\x11\x06\x0b\x14\x08\x04\x05\x0a\x11\x06\x10\x07\x0f\x0f\x07\x0b\x11\x06\x06\x0c\x09\x0d\x05\x08\x04\x05\x10\x09\x16\x07\x0b\x0c\x0f\x0a\x0e\x10\x0c\x0e\x06\x0b\x10\x0b\x10\x11\x06\x05\x0a\x07\x04\x08\x10\x05\x03\x0c\x0a\x07\x09\x0b\x0a\x13\x04\x0d\x03\x07\x13\x08\x0c\x05\x11\x07\x0a\x0a\x0d\x11\x14\x0d\x04\x0c\x0a\x0c\x07\x08\x0d\x08\x08\x05\x0e\x0d\x16\x09\x0c\x06\x09\x04\x08\x05\x09\x0e\x05\x0a\x04\x08\x0e\x10\x0e\x09\x04\x0a\x12\x11\x0e\x14\x06\x0d\x0c\x07\x09\x16\x05\x0b\x09\x0b\x11\x07\x09\x06\x08\x06\x08\x06\x08\x06\x08\x06\x08\x06\x08\x06\x08\x06\x08\x06\x08\x07\x09\x07\x09\x08\x14\x12\x04\x13\x09\x13\x11\x14\x0d\x12\x1c\x1b\x0b\x05\x13\x0d\x0d\x04\x05\x05\x09\x05\x0c\x1d\x16\x16\x15\x0f\x0f\x14\x07\x03\x09\x07\x07\x0f\x0d\x08\x11\x03\x09\x11\x07\x05\x04\x07\x05\x0e\x0e\x09\x08\x0b\x08\x07\x04\x0d\x0d\x06\x05\x05\x0a\x0a\x0c\x06\x04\x0f\x07\x06\x0e\x06\x0c\x0f\x0f\x0f\x06\x08\x08\x0a\x0a\x08\x0e\x06\x0b\x07\x0c\x0c\x0a\x08\x09\x09\x0c\x14\x08\x04\x09\x15\x0b\x17\x03\x04\x0d\x04\x05\x04\x03\x05\x0c\x0a\x0a\x08\x0c\x08\x06\x0a\x06\x0b\x0d\x06\x06\x08\x05\x0a\x0b\x07\x14\x0f\x0e\x03\x06\x0d\x04\x04\x0a\x0f\x07\x08\x0b\x07\x05\x06\x05\x05\x06\x04\x05\x05\x06\x04\x12\x0a\x06\x06\x0e\x10\x05\x07\x05\x08\x0e\x0c\x09\x04\x0d\x0b\x09\x09\x06\x0c\x04\x09\x05\x0a\x06\x0c\x0a\x0f\x14\x0c\x08\x04\x03\x04\x07\x05\x0a\x0b\x04\x0b\x04\x07\x0d\x0c\x05\x11\x0c\x0d\x0a\x0a\x05\x09\x09\x06\x10\x07\x06\x05\x04\x09\x07\x05\x06\x07\x05\x09\x12\x05\x03\x07\x0b\x0d\x08\x10\x07\x08\x0e\x09\x08\x0b\x02\x03\x06\x0c\x0a\x0e\x07\x02\x06\x0c\x0c\x03\x03\x09\x06\x04\x0a\x0f\x07\x09\x1e\x0b\x04\x09\x0a\x09\x0b\x05\x08\x06\x0e\x06\x0e\x09\x0e\x0d\x08\x05\x08\x04\x03\x06\x04\x05\x10\x0b\x07\x05\x08\x05\x06\x11\x13\x0b\x0a\x07\x07\x0e\x11\x14\x07\x0a\x07\x09\x0c\x03\x0a\x06\x04\x06\x09\x0b\x10\x13\x04\x08\x0e\x0f\x0a\x18\x1a\x09\x09\x07\x0d\x09\x0a\x0e\x10\x08\x0d\x0c\x0b\x04\x06\x11\x14\x03\x07\x06\x03\x04\x0f\x11\x06\x0e\x04\x09\x0d\x06\x0d\x06\x0b\x0c\x04\x05\x0d\x0c\x04\x06\x04\x07\x06\x06\x07\x09\x0a\x03\x0a\x09\x09\x07\x0d\x04\x10\x06\x0b\x05\x04\x08\x06\x06\x03\x0e\x05\x06\x07\x06\x07\x0a\x05\x0d\x0a\x05\x06\x0d\x06\x04\x07\x0a\x06\x11\x0d\x07\x06\x09\x0b\x0c\x05\x05\x07\x13\x03\x17\x0d\x0a\x04\x06\x08\x0b\x02\x10\x07\x0d\x0c\x07\x11\x08\x0d\x0b\x0a\x0d\x11\x0e\x10\x08\x0b\x09\x08\x11\x0b\x11\x0a\x10\x0a\x10\x0d\x10\x19\x10\x10\x12\x10\x17\x04\x03\x02\x04\x03\x10\x0b\x02\x0d\x0f\x08\x0b\x0e\x11\x0c\x07\x10\x06\x05\x06\x06\x06\x03\x05\x05\x08\x0b\x09\x08\x06\x09\x19\x06\x0a\x0d\x0c\x11\x14\x0e\x06\x15\x0e\x0f\x10\x11\x0c\x0b\x0d\x0c\x06\x0e\x0f\x0f\x08\x0e\x10\x0b\x09\x12\x16\x0f\x10\x13\x11\x08\x08\x0b\x08\x0d\x10\x0b\x16\x09\x11\x11\x0e\x07\x0c\x07\x0a\x08\x01\x10\x15\x10\x01\x1b\x0a\x04\x15\x15\x18\x07\x09\x0a\x02\x04\x08\x04\x03\x03\x05\x04\x04\x03\x05\x05\x04\x0a\x0c\x04\x04\x07\x03\x01\x02\x01\x02\x04\x04\x12\x02\x01\x03\x02\x02\x02\x07\x03\x02\x02\x04\x02\x05\x05\x05\x13\x05\x05\x05\x06\x05\x05\x05\x0f\x06\x05\x05\x0a\x0d\x0d\x0c\x11\x0d\x0c\x0c\x0c\x0a\x0d\x0d\x0c\x0b\x0d\x0c\x12\x12\x13\x13\x19\x19\x12\x12\x14\x12\x14\x12\x12\x17\x1f\x0c\x13\x13\x0e\x17\x0d\x0a\x0a\x0c\x10\x0b\x10\x07\x14\x0b\x0f\x08\x07\x07\x06\x0a\x09\x0e\x07\x09\x05\x0d\x0a\x12\x08\x0a\x07\x0c\x08\x07\x07\x05\x0d\x07\x06\x07\x15\x06\x07\x06\x0d\x08\x07\x06\x06\x0d\x0e\x18\x11\x0b\x0c\x0c\x05\x08\x02\x08\x11\x0a\x04\x0a\x07\x10\x06\x0e\x02\x0c\x08\x07\x08\x12\x07\x0d\x07\x07\x05\x0e\x07\x02\x08\x02\x0e\x07\x04\x10\x0a\x0d\x02\x05\x02\x02\x07\x07\x0d\x08\x05\x09\x09\x06\x06\x0a\x11\x06\x24\x09\x04\x0d\x06\x09\x16\x08\x07\x05\x07\x0e\x09\x07\x06\x0b\x05\x1e\x04\x04\x07\x02\x06\x07\x06\x02\x05\x0a\x08\x08\x07\x0e\x0b\x02\x06\x0e\x0a\x04\x07\x08\x0d\x09\x07\x07\x09\x0a\x06\x05\x07\x06\x07\x0a\x09\x0a\x10\x09\x04\x07\x08\x05\x18\x0a\x0a\x0a\x05\x09\x08\x06\x06\x08\x0a\x07\x0d\x05\x0e\x07\x09\x0b\x06\x05\x05\x04\x0b\x04\x06\x04\x10\x10\x0b\x08\x06\x12\x10\x0b\x17\x08\x05\x08\x05\x07\x07\x06\x02\x06\x0c\x0f\x0a\x05\x06\x09\x09\x08\x12\x08\x0c\x0a\x07\x07\x08\x0b\x11\x0b\x0c\x05\x09\x10\x14\x04\x1b\x04\x08\x0a\x07\x0b\x0c\x07\x05\x02\x0f\x06\x06\x08\x07\x06\x13\x0e\x02\x07\x0a\x0c\x15\x09\x16\x11\x07\x07\x0d\x05\x06\x05\x07\x0c\x06\x08\x07\x08\x05"
+var _emojiCharacters = [...]byte{0xf0, 0x9f, 0x98, 0x80, 0xf0, 0x9f, 0x98, 0x83, 0xf0, 0x9f, 0x98, 0x84, 0xf0, 0x9f, 0x98, 0x81, 0xf0, 0x9f, 0x98, 0x86, 0xf0, 0x9f, 0x98, 0x86, 0xf0, 0x9f, 0x98, 0x85, 0xf0, 0x9f, 0xa4, 0xa3, 0xf0, 0x9f, 0x98, 0x82, 0xf0, 0x9f, 0x99, 0x82, 0xf0, 0x9f, 0x99, 0x83, 0xf0, 0x9f, 0xab, 0xa0, 0xf0, 0x9f, 0x98, 0x89, 0xf0, 0x9f, 0x98, 0x8a, 0xf0, 0x9f, 0x98, 0x87, 0xf0, 0x9f, 0xa5, 0xb0, 0xf0, 0x9f, 0x98, 0x8d, 0xf0, 0x9f, 0xa4, 0xa9, 0xf0, 0x9f, 0x98, 0x98, 0xf0, 0x9f, 0x98, 0x97, 0xe2, 0x98, 0xba, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x98, 0x9a, 0xf0, 0x9f, 0x98, 0x99, 0xf0, 0x9f, 0xa5, 0xb2, 0xf0, 0x9f, 0x98, 0x8b, 0xf0, 0x9f, 0x98, 0x9b, 0xf0, 0x9f, 0x98, 0x9c, 0xf0, 0x9f, 0xa4, 0xaa, 0xf0, 0x9f, 0x98, 0x9d, 0xf0, 0x9f, 0xa4, 0x91, 0xf0, 0x9f, 0xa4, 0x97, 0xf0, 0x9f, 0xa4, 0xad, 0xf0, 0x9f, 0xab, 0xa2, 0xf0, 0x9f, 0xab, 0xa3, 0xf0, 0x9f, 0xa4, 0xab, 0xf0, 0x9f, 0xa4, 0x94, 0xf0, 0x9f, 0xab, 0xa1, 0xf0, 0x9f, 0xa4, 0x90, 0xf0, 0x9f, 0xa4, 0xa8, 0xf0, 0x9f, 0x98, 0x90, 0xf0, 0x9f, 0x98, 0x91, 0xf0, 0x9f, 0x98, 0xb6, 0xf0, 0x9f, 0xab, 0xa5, 0xf0, 0x9f, 0x98, 0xb6, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8c, 0xab, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x98, 0x8f, 0xf0, 0x9f, 0x98, 0x92, 0xf0, 0x9f, 0x99, 0x84, 0xf0, 0x9f, 0x98, 0xac, 0xf0, 0x9f, 0x98, 0xae, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x92, 0xa8, 0xf0, 0x9f, 0xa4, 0xa5, 0xf0, 0x9f, 0xab, 0xa8, 0xf0, 0x9f, 0x98, 0x8c, 0xf0, 0x9f, 0x98, 0x94, 0xf0, 0x9f, 0x98, 0xaa, 0xf0, 0x9f, 0xa4, 0xa4, 0xf0, 0x9f, 0x98, 0xb4, 0xf0, 0x9f, 0x98, 0xb7, 0xf0, 0x9f, 0xa4, 0x92, 0xf0, 0x9f, 0xa4, 0x95, 0xf0, 0x9f, 0xa4, 0xa2, 0xf0, 0x9f, 0xa4, 0xae, 0xf0, 0x9f, 0xa4, 0xa7, 0xf0, 0x9f, 0xa5, 0xb5, 0xf0, 0x9f, 0xa5, 0xb6, 0xf0, 0x9f, 0xa5, 0xb4, 0xf0, 0x9f, 0x98, 0xb5, 0xf0, 0x9f, 0x98, 0xb5, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x92, 0xab, 0xf0, 0x9f, 0xa4, 0xaf, 0xf0, 0x9f, 0xa4, 0xa0, 0xf0, 0x9f, 0xa5, 0xb3, 0xf0, 0x9f, 0xa5, 0xb8, 0xf0, 0x9f, 0x98, 0x8e, 0xf0, 0x9f, 0xa4, 0x93, 0xf0, 0x9f, 0xa7, 0x90, 0xf0, 0x9f, 0x98, 0x95, 0xf0, 0x9f, 0xab, 0xa4, 0xf0, 0x9f, 0x98, 0x9f, 0xf0, 0x9f, 0x99, 0x81, 0xe2, 0x98, 0xb9, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x98, 0xae, 0xf0, 0x9f, 0x98, 0xaf, 0xf0, 0x9f, 0x98, 0xb2, 0xf0, 0x9f, 0x98, 0xb3, 0xf0, 0x9f, 0xa5, 0xba, 0xf0, 0x9f, 0xa5, 0xb9, 0xf0, 0x9f, 0x98, 0xa6, 0xf0, 0x9f, 0x98, 0xa7, 0xf0, 0x9f, 0x98, 0xa8, 0xf0, 0x9f, 0x98, 0xb0, 0xf0, 0x9f, 0x98, 0xa5, 0xf0, 0x9f, 0x98, 0xa2, 0xf0, 0x9f, 0x98, 0xad, 0xf0, 0x9f, 0x98, 0xb1, 0xf0, 0x9f, 0x98, 0x96, 0xf0, 0x9f, 0x98, 0xa3, 0xf0, 0x9f, 0x98, 0x9e, 0xf0, 0x9f, 0x98, 0x93, 0xf0, 0x9f, 0x98, 0xa9, 0xf0, 0x9f, 0x98, 0xab, 0xf0, 0x9f, 0xa5, 0xb1, 0xf0, 0x9f, 0x98, 0xa4, 0xf0, 0x9f, 0x98, 0xa1, 0xf0, 0x9f, 0x98, 0xa1, 0xf0, 0x9f, 0x98, 0xa0, 0xf0, 0x9f, 0xa4, 0xac, 0xf0, 0x9f, 0x98, 0x88, 0xf0, 0x9f, 0x91, 0xbf, 0xf0, 0x9f, 0x92, 0x80, 0xe2, 0x98, 0xa0, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x92, 0xa9, 0xf0, 0x9f, 0x92, 0xa9, 0xf0, 0x9f, 0x92, 0xa9, 0xf0, 0x9f, 0xa4, 0xa1, 0xf0, 0x9f, 0x91, 0xb9, 0xf0, 0x9f, 0x91, 0xba, 0xf0, 0x9f, 0x91, 0xbb, 0xf0, 0x9f, 0x91, 0xbd, 0xf0, 0x9f, 0x91, 0xbe, 0xf0, 0x9f, 0xa4, 0x96, 0xf0, 0x9f, 0x98, 0xba, 0xf0, 0x9f, 0x98, 0xb8, 0xf0, 0x9f, 0x98, 0xb9, 0xf0, 0x9f, 0x98, 0xbb, 0xf0, 0x9f, 0x98, 0xbc, 0xf0, 0x9f, 0x98, 0xbd, 0xf0, 0x9f, 0x99, 0x80, 0xf0, 0x9f, 0x98, 0xbf, 0xf0, 0x9f, 0x98, 0xbe, 0xf0, 0x9f, 0x99, 0x88, 0xf0, 0x9f, 0x99, 0x89, 0xf0, 0x9f, 0x99, 0x8a, 0xf0, 0x9f, 0x92, 0x8c, 0xf0, 0x9f, 0x92, 0x98, 0xf0, 0x9f, 0x92, 0x9d, 0xf0, 0x9f, 0x92, 0x96, 0xf0, 0x9f, 0x92, 0x97, 0xf0, 0x9f, 0x92, 0x93, 0xf0, 0x9f, 0x92, 0x9e, 0xf0, 0x9f, 0x92, 0x95, 0xf0, 0x9f, 0x92, 0x9f, 0xe2, 0x9d, 0xa3, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x92, 0x94, 0xe2, 0x9d, 0xa4, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x94, 0xa5, 0xe2, 0x9d, 0xa4, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa9, 0xb9, 0xe2, 0x9d, 0xa4, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa9, 0xb7, 0xf0, 0x9f, 0xa7, 0xa1, 0xf0, 0x9f, 0x92, 0x9b, 0xf0, 0x9f, 0x92, 0x9a, 0xf0, 0x9f, 0x92, 0x99, 0xf0, 0x9f, 0xa9, 0xb5, 0xf0, 0x9f, 0x92, 0x9c, 0xf0, 0x9f, 0xa4, 0x8e, 0xf0, 0x9f, 0x96, 0xa4, 0xf0, 0x9f, 0xa9, 0xb6, 0xf0, 0x9f, 0xa4, 0x8d, 0xf0, 0x9f, 0x92, 0x8b, 0xf0, 0x9f, 0x92, 0xaf, 0xf0, 0x9f, 0x92, 0xa2, 0xf0, 0x9f, 0x92, 0xa5, 0xf0, 0x9f, 0x92, 0xa5, 0xf0, 0x9f, 0x92, 0xab, 0xf0, 0x9f, 0x92, 0xa6, 0xf0, 0x9f, 0x92, 0xa8, 0xf0, 0x9f, 0x95, 0xb3, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x92, 0xac, 0xf0, 0x9f, 0x91, 0x81, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x97, 0xa8, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x97, 0xa8, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x97, 0xaf, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x92, 0xad, 0xf0, 0x9f, 0x92, 0xa4, 0xf0, 0x9f, 0x91, 0x8b, 0xf0, 0x9f, 0xa4, 0x9a, 0xf0, 0x9f, 0x96, 0x90, 0xef, 0xb8, 0x8f, 0xe2, 0x9c, 0x8b, 0xe2, 0x9c, 0x8b, 0xf0, 0x9f, 0x96, 0x96, 0xf0, 0x9f, 0xab, 0xb1, 0xf0, 0x9f, 0xab, 0xb2, 0xf0, 0x9f, 0xab, 0xb3, 0xf0, 0x9f, 0xab, 0xb4, 0xf0, 0x9f, 0xab, 0xb7, 0xf0, 0x9f, 0xab, 0xb8, 0xf0, 0x9f, 0x91, 0x8c, 0xf0, 0x9f, 0xa4, 0x8c, 0xf0, 0x9f, 0xa4, 0x8f, 0xe2, 0x9c, 0x8c, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa4, 0x9e, 0xf0, 0x9f, 0xab, 0xb0, 0xf0, 0x9f, 0xa4, 0x9f, 0xf0, 0x9f, 0xa4, 0x98, 0xf0, 0x9f, 0xa4, 0x99, 0xf0, 0x9f, 0x91, 0x88, 0xf0, 0x9f, 0x91, 0x89, 0xf0, 0x9f, 0x91, 0x86, 0xf0, 0x9f, 0x96, 0x95, 0xf0, 0x9f, 0x96, 0x95, 0xf0, 0x9f, 0x91, 0x87, 0xe2, 0x98, 0x9d, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xab, 0xb5, 0xf0, 0x9f, 0x91, 0x8d, 0xf0, 0x9f, 0x91, 0x8d, 0xf0, 0x9f, 0x91, 0x8e, 0xf0, 0x9f, 0x91, 0x8e, 0xe2, 0x9c, 0x8a, 0xe2, 0x9c, 0x8a, 0xf0, 0x9f, 0x91, 0x8a, 0xf0, 0x9f, 0x91, 0x8a, 0xf0, 0x9f, 0x91, 0x8a, 0xf0, 0x9f, 0xa4, 0x9b, 0xf0, 0x9f, 0xa4, 0x9c, 0xf0, 0x9f, 0x91, 0x8f, 0xf0, 0x9f, 0x99, 0x8c, 0xf0, 0x9f, 0xab, 0xb6, 0xf0, 0x9f, 0x91, 0x90, 0xf0, 0x9f, 0xa4, 0xb2, 0xf0, 0x9f, 0xa4, 0x9d, 0xf0, 0x9f, 0x99, 0x8f, 0xe2, 0x9c, 0x8d, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x92, 0x85, 0xf0, 0x9f, 0xa4, 0xb3, 0xf0, 0x9f, 0x92, 0xaa, 0xf0, 0x9f, 0xa6, 0xbe, 0xf0, 0x9f, 0xa6, 0xbf, 0xf0, 0x9f, 0xa6, 0xb5, 0xf0, 0x9f, 0xa6, 0xb6, 0xf0, 0x9f, 0x91, 0x82, 0xf0, 0x9f, 0xa6, 0xbb, 0xf0, 0x9f, 0x91, 0x83, 0xf0, 0x9f, 0xa7, 0xa0, 0xf0, 0x9f, 0xab, 0x80, 0xf0, 0x9f, 0xab, 0x81, 0xf0, 0x9f, 0xa6, 0xb7, 0xf0, 0x9f, 0xa6, 0xb4, 0xf0, 0x9f, 0x91, 0x80, 0xf0, 0x9f, 0x91, 0x81, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x91, 0x85, 0xf0, 0x9f, 0x91, 0x84, 0xf0, 0x9f, 0xab, 0xa6, 0xf0, 0x9f, 0x91, 0xb6, 0xf0, 0x9f, 0xa7, 0x92, 0xf0, 0x9f, 0x91, 0xa6, 0xf0, 0x9f, 0x91, 0xa7, 0xf0, 0x9f, 0xa7, 0x91, 0xf0, 0x9f, 0x91, 0xb1, 0xf0, 0x9f, 0x91, 0xa8, 0xf0, 0x9f, 0xa7, 0x94, 0xf0, 0x9f, 0xa7, 0x94, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x94, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xb0, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xb1, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xb3, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xb2, 0xf0, 0x9f, 0x91, 0xa9, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xb0, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xb0, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xb1, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xb1, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xb3, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xb3, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xb2, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xb2, 0xf0, 0x9f, 0x91, 0xb1, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x91, 0xb1, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x91, 0xb1, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x93, 0xf0, 0x9f, 0x91, 0xb4, 0xf0, 0x9f, 0x91, 0xb5, 0xf0, 0x9f, 0x99, 0x8d, 0xf0, 0x9f, 0x99, 0x8d, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x99, 0x8d, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x99, 0x8e, 0xf0, 0x9f, 0x99, 0x8e, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x99, 0x8e, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x99, 0x85, 0xf0, 0x9f, 0x99, 0x85, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x99, 0x85, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x99, 0x85, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x99, 0x85, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x99, 0x86, 0xf0, 0x9f, 0x99, 0x86, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x99, 0x86, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x92, 0x81, 0xf0, 0x9f, 0x92, 0x81, 0xf0, 0x9f, 0x92, 0x81, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x92, 0x81, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x92, 0x81, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x92, 0x81, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x99, 0x8b, 0xf0, 0x9f, 0x99, 0x8b, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x99, 0x8b, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x8f, 0xf0, 0x9f, 0xa7, 0x8f, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x8f, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x99, 0x87, 0xf0, 0x9f, 0x99, 0x87, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x99, 0x87, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa4, 0xa6, 0xf0, 0x9f, 0xa4, 0xa6, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa4, 0xa6, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa4, 0xb7, 0xf0, 0x9f, 0xa4, 0xb7, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa4, 0xb7, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xe2, 0x9a, 0x95, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xe2, 0x9a, 0x95, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xe2, 0x9a, 0x95, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8e, 0x93, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8e, 0x93, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8e, 0x93, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8f, 0xab, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8f, 0xab, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8f, 0xab, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xe2, 0x9a, 0x96, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xe2, 0x9a, 0x96, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xe2, 0x9a, 0x96, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8c, 0xbe, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8c, 0xbe, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8c, 0xbe, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8d, 0xb3, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8d, 0xb3, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8d, 0xb3, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x94, 0xa7, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x94, 0xa7, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x94, 0xa7, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8f, 0xad, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8f, 0xad, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8f, 0xad, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x92, 0xbc, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x92, 0xbc, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x92, 0xbc, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x94, 0xac, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x94, 0xac, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x94, 0xac, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x92, 0xbb, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x92, 0xbb, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x92, 0xbb, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8e, 0xa4, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8e, 0xa4, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8e, 0xa4, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8e, 0xa8, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8e, 0xa8, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8e, 0xa8, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xe2, 0x9c, 0x88, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xe2, 0x9c, 0x88, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xe2, 0x9c, 0x88, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x9a, 0x80, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x9a, 0x80, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x9a, 0x80, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x9a, 0x92, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x9a, 0x92, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x9a, 0x92, 0xf0, 0x9f, 0x91, 0xae, 0xf0, 0x9f, 0x91, 0xae, 0xf0, 0x9f, 0x91, 0xae, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x91, 0xae, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x95, 0xb5, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x95, 0xb5, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x95, 0xb5, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x92, 0x82, 0xf0, 0x9f, 0x92, 0x82, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x92, 0x82, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa5, 0xb7, 0xf0, 0x9f, 0x91, 0xb7, 0xf0, 0x9f, 0x91, 0xb7, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x91, 0xb7, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xab, 0x85, 0xf0, 0x9f, 0xa4, 0xb4, 0xf0, 0x9f, 0x91, 0xb8, 0xf0, 0x9f, 0x91, 0xb3, 0xf0, 0x9f, 0x91, 0xb3, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x91, 0xb3, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x91, 0xb2, 0xf0, 0x9f, 0xa7, 0x95, 0xf0, 0x9f, 0xa4, 0xb5, 0xf0, 0x9f, 0xa4, 0xb5, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa4, 0xb5, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x91, 0xb0, 0xf0, 0x9f, 0x91, 0xb0, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x91, 0xb0, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x91, 0xb0, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa4, 0xb0, 0xf0, 0x9f, 0xab, 0x83, 0xf0, 0x9f, 0xab, 0x84, 0xf0, 0x9f, 0xa4, 0xb1, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8d, 0xbc, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8d, 0xbc, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8d, 0xbc, 0xf0, 0x9f, 0x91, 0xbc, 0xf0, 0x9f, 0x8e, 0x85, 0xf0, 0x9f, 0xa4, 0xb6, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8e, 0x84, 0xf0, 0x9f, 0xa6, 0xb8, 0xf0, 0x9f, 0xa6, 0xb8, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa6, 0xb8, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa6, 0xb9, 0xf0, 0x9f, 0xa6, 0xb9, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa6, 0xb9, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x99, 0xf0, 0x9f, 0xa7, 0x99, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x99, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x9a, 0xf0, 0x9f, 0xa7, 0x9a, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x9a, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x9b, 0xf0, 0x9f, 0xa7, 0x9b, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x9b, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x9c, 0xf0, 0x9f, 0xa7, 0x9c, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x9c, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x9d, 0xf0, 0x9f, 0xa7, 0x9d, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x9d, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x9e, 0xf0, 0x9f, 0xa7, 0x9e, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x9e, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x9f, 0xf0, 0x9f, 0xa7, 0x9f, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x9f, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x8c, 0xf0, 0x9f, 0x92, 0x86, 0xf0, 0x9f, 0x92, 0x86, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x92, 0x86, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x92, 0x87, 0xf0, 0x9f, 0x92, 0x87, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x92, 0x87, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x9a, 0xb6, 0xf0, 0x9f, 0x9a, 0xb6, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x9a, 0xb6, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x8d, 0xf0, 0x9f, 0xa7, 0x8d, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x8d, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x8e, 0xf0, 0x9f, 0xa7, 0x8e, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x8e, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xaf, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xaf, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xaf, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xbc, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xbc, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xbc, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xbd, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xbd, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xbd, 0xf0, 0x9f, 0x8f, 0x83, 0xf0, 0x9f, 0x8f, 0x83, 0xf0, 0x9f, 0x8f, 0x83, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x83, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x92, 0x83, 0xf0, 0x9f, 0x92, 0x83, 0xf0, 0x9f, 0x95, 0xba, 0xf0, 0x9f, 0x95, 0xb4, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x91, 0xaf, 0xf0, 0x9f, 0x91, 0xaf, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x91, 0xaf, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x96, 0xf0, 0x9f, 0xa7, 0x96, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x96, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x97, 0xf0, 0x9f, 0xa7, 0x97, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x97, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa4, 0xba, 0xf0, 0x9f, 0x8f, 0x87, 0xe2, 0x9b, 0xb7, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x82, 0xf0, 0x9f, 0x8f, 0x8c, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x8c, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x8c, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x84, 0xf0, 0x9f, 0x8f, 0x84, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x84, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x9a, 0xa3, 0xf0, 0x9f, 0x9a, 0xa3, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x9a, 0xa3, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x8a, 0xf0, 0x9f, 0x8f, 0x8a, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x8a, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xe2, 0x9b, 0xb9, 0xef, 0xb8, 0x8f, 0xe2, 0x9b, 0xb9, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xe2, 0x9b, 0xb9, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xe2, 0x9b, 0xb9, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xe2, 0x9b, 0xb9, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x8b, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x8b, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x8b, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x9a, 0xb4, 0xf0, 0x9f, 0x9a, 0xb4, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x9a, 0xb4, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x9a, 0xb5, 0xf0, 0x9f, 0x9a, 0xb5, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x9a, 0xb5, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa4, 0xb8, 0xf0, 0x9f, 0xa4, 0xb8, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa4, 0xb8, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa4, 0xbc, 0xf0, 0x9f, 0xa4, 0xbc, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa4, 0xbc, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa4, 0xbd, 0xf0, 0x9f, 0xa4, 0xbd, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa4, 0xbd, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa4, 0xbe, 0xf0, 0x9f, 0xa4, 0xbe, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa4, 0xbe, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa4, 0xb9, 0xf0, 0x9f, 0xa4, 0xb9, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa4, 0xb9, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x98, 0xf0, 0x9f, 0xa7, 0x98, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0x98, 0xe2, 0x80, 0x8d, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x9b, 0x80, 0xf0, 0x9f, 0x9b, 0x8c, 0xf0, 0x9f, 0xa7, 0x91, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa4, 0x9d, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa7, 0x91, 0xf0, 0x9f, 0x91, 0xad, 0xf0, 0x9f, 0x91, 0xab, 0xf0, 0x9f, 0x91, 0xac, 0xf0, 0x9f, 0x92, 0x8f, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xe2, 0x9d, 0xa4, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x92, 0x8b, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa8, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xe2, 0x9d, 0xa4, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x92, 0x8b, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa8, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xe2, 0x9d, 0xa4, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x92, 0x8b, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa9, 0xf0, 0x9f, 0x92, 0x91, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xe2, 0x9d, 0xa4, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa8, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xe2, 0x9d, 0xa4, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa8, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xe2, 0x9d, 0xa4, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa9, 0xf0, 0x9f, 0x91, 0xaa, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa6, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa7, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa7, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa6, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa6, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa6, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa7, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa7, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa6, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa7, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa7, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa6, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa6, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa6, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa7, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa7, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa6, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa7, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa7, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa6, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa6, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa6, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa7, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa7, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa6, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa6, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa6, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa7, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa7, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa6, 0xf0, 0x9f, 0x91, 0xa8, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa7, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa7, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa6, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa6, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa6, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa7, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa7, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa6, 0xf0, 0x9f, 0x91, 0xa9, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa7, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x91, 0xa7, 0xf0, 0x9f, 0x97, 0xa3, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x91, 0xa4, 0xf0, 0x9f, 0x91, 0xa5, 0xf0, 0x9f, 0xab, 0x82, 0xf0, 0x9f, 0x91, 0xa3, 0xf0, 0x9f, 0x90, 0xb5, 0xf0, 0x9f, 0x90, 0x92, 0xf0, 0x9f, 0xa6, 0x8d, 0xf0, 0x9f, 0xa6, 0xa7, 0xf0, 0x9f, 0x90, 0xb6, 0xf0, 0x9f, 0x90, 0x95, 0xf0, 0x9f, 0xa6, 0xae, 0xf0, 0x9f, 0x90, 0x95, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0xa6, 0xba, 0xf0, 0x9f, 0x90, 0xa9, 0xf0, 0x9f, 0x90, 0xba, 0xf0, 0x9f, 0xa6, 0x8a, 0xf0, 0x9f, 0xa6, 0x9d, 0xf0, 0x9f, 0x90, 0xb1, 0xf0, 0x9f, 0x90, 0x88, 0xf0, 0x9f, 0x90, 0x88, 0xe2, 0x80, 0x8d, 0xe2, 0xac, 0x9b, 0xf0, 0x9f, 0xa6, 0x81, 0xf0, 0x9f, 0x90, 0xaf, 0xf0, 0x9f, 0x90, 0x85, 0xf0, 0x9f, 0x90, 0x86, 0xf0, 0x9f, 0x90, 0xb4, 0xf0, 0x9f, 0xab, 0x8e, 0xf0, 0x9f, 0xab, 0x8f, 0xf0, 0x9f, 0x90, 0x8e, 0xf0, 0x9f, 0xa6, 0x84, 0xf0, 0x9f, 0xa6, 0x93, 0xf0, 0x9f, 0xa6, 0x8c, 0xf0, 0x9f, 0xa6, 0xac, 0xf0, 0x9f, 0x90, 0xae, 0xf0, 0x9f, 0x90, 0x82, 0xf0, 0x9f, 0x90, 0x83, 0xf0, 0x9f, 0x90, 0x84, 0xf0, 0x9f, 0x90, 0xb7, 0xf0, 0x9f, 0x90, 0x96, 0xf0, 0x9f, 0x90, 0x97, 0xf0, 0x9f, 0x90, 0xbd, 0xf0, 0x9f, 0x90, 0x8f, 0xf0, 0x9f, 0x90, 0x91, 0xf0, 0x9f, 0x90, 0x90, 0xf0, 0x9f, 0x90, 0xaa, 0xf0, 0x9f, 0x90, 0xab, 0xf0, 0x9f, 0xa6, 0x99, 0xf0, 0x9f, 0xa6, 0x92, 0xf0, 0x9f, 0x90, 0x98, 0xf0, 0x9f, 0xa6, 0xa3, 0xf0, 0x9f, 0xa6, 0x8f, 0xf0, 0x9f, 0xa6, 0x9b, 0xf0, 0x9f, 0x90, 0xad, 0xf0, 0x9f, 0x90, 0x81, 0xf0, 0x9f, 0x90, 0x80, 0xf0, 0x9f, 0x90, 0xb9, 0xf0, 0x9f, 0x90, 0xb0, 0xf0, 0x9f, 0x90, 0x87, 0xf0, 0x9f, 0x90, 0xbf, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa6, 0xab, 0xf0, 0x9f, 0xa6, 0x94, 0xf0, 0x9f, 0xa6, 0x87, 0xf0, 0x9f, 0x90, 0xbb, 0xf0, 0x9f, 0x90, 0xbb, 0xe2, 0x80, 0x8d, 0xe2, 0x9d, 0x84, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x90, 0xa8, 0xf0, 0x9f, 0x90, 0xbc, 0xf0, 0x9f, 0xa6, 0xa5, 0xf0, 0x9f, 0xa6, 0xa6, 0xf0, 0x9f, 0xa6, 0xa8, 0xf0, 0x9f, 0xa6, 0x98, 0xf0, 0x9f, 0xa6, 0xa1, 0xf0, 0x9f, 0x90, 0xbe, 0xf0, 0x9f, 0x90, 0xbe, 0xf0, 0x9f, 0xa6, 0x83, 0xf0, 0x9f, 0x90, 0x94, 0xf0, 0x9f, 0x90, 0x93, 0xf0, 0x9f, 0x90, 0xa3, 0xf0, 0x9f, 0x90, 0xa4, 0xf0, 0x9f, 0x90, 0xa5, 0xf0, 0x9f, 0x90, 0xa6, 0xf0, 0x9f, 0x90, 0xa7, 0xf0, 0x9f, 0x95, 0x8a, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa6, 0x85, 0xf0, 0x9f, 0xa6, 0x86, 0xf0, 0x9f, 0xa6, 0xa2, 0xf0, 0x9f, 0xa6, 0x89, 0xf0, 0x9f, 0xa6, 0xa4, 0xf0, 0x9f, 0xaa, 0xb6, 0xf0, 0x9f, 0xa6, 0xa9, 0xf0, 0x9f, 0xa6, 0x9a, 0xf0, 0x9f, 0xa6, 0x9c, 0xf0, 0x9f, 0xaa, 0xbd, 0xf0, 0x9f, 0x90, 0xa6, 0xe2, 0x80, 0x8d, 0xe2, 0xac, 0x9b, 0xf0, 0x9f, 0xaa, 0xbf, 0xf0, 0x9f, 0x90, 0xb8, 0xf0, 0x9f, 0x90, 0x8a, 0xf0, 0x9f, 0x90, 0xa2, 0xf0, 0x9f, 0xa6, 0x8e, 0xf0, 0x9f, 0x90, 0x8d, 0xf0, 0x9f, 0x90, 0xb2, 0xf0, 0x9f, 0x90, 0x89, 0xf0, 0x9f, 0xa6, 0x95, 0xf0, 0x9f, 0xa6, 0x96, 0xf0, 0x9f, 0x90, 0xb3, 0xf0, 0x9f, 0x90, 0x8b, 0xf0, 0x9f, 0x90, 0xac, 0xf0, 0x9f, 0x90, 0xac, 0xf0, 0x9f, 0xa6, 0xad, 0xf0, 0x9f, 0x90, 0x9f, 0xf0, 0x9f, 0x90, 0xa0, 0xf0, 0x9f, 0x90, 0xa1, 0xf0, 0x9f, 0xa6, 0x88, 0xf0, 0x9f, 0x90, 0x99, 0xf0, 0x9f, 0x90, 0x9a, 0xf0, 0x9f, 0xaa, 0xb8, 0xf0, 0x9f, 0xaa, 0xbc, 0xf0, 0x9f, 0x90, 0x8c, 0xf0, 0x9f, 0xa6, 0x8b, 0xf0, 0x9f, 0x90, 0x9b, 0xf0, 0x9f, 0x90, 0x9c, 0xf0, 0x9f, 0x90, 0x9d, 0xf0, 0x9f, 0x90, 0x9d, 0xf0, 0x9f, 0xaa, 0xb2, 0xf0, 0x9f, 0x90, 0x9e, 0xf0, 0x9f, 0xa6, 0x97, 0xf0, 0x9f, 0xaa, 0xb3, 0xf0, 0x9f, 0x95, 0xb7, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x95, 0xb8, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa6, 0x82, 0xf0, 0x9f, 0xa6, 0x9f, 0xf0, 0x9f, 0xaa, 0xb0, 0xf0, 0x9f, 0xaa, 0xb1, 0xf0, 0x9f, 0xa6, 0xa0, 0xf0, 0x9f, 0x92, 0x90, 0xf0, 0x9f, 0x8c, 0xb8, 0xf0, 0x9f, 0x92, 0xae, 0xf0, 0x9f, 0xaa, 0xb7, 0xf0, 0x9f, 0x8f, 0xb5, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8c, 0xb9, 0xf0, 0x9f, 0xa5, 0x80, 0xf0, 0x9f, 0x8c, 0xba, 0xf0, 0x9f, 0x8c, 0xbb, 0xf0, 0x9f, 0x8c, 0xbc, 0xf0, 0x9f, 0x8c, 0xb7, 0xf0, 0x9f, 0xaa, 0xbb, 0xf0, 0x9f, 0x8c, 0xb1, 0xf0, 0x9f, 0xaa, 0xb4, 0xf0, 0x9f, 0x8c, 0xb2, 0xf0, 0x9f, 0x8c, 0xb3, 0xf0, 0x9f, 0x8c, 0xb4, 0xf0, 0x9f, 0x8c, 0xb5, 0xf0, 0x9f, 0x8c, 0xbe, 0xf0, 0x9f, 0x8c, 0xbf, 0xe2, 0x98, 0x98, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8d, 0x80, 0xf0, 0x9f, 0x8d, 0x81, 0xf0, 0x9f, 0x8d, 0x82, 0xf0, 0x9f, 0x8d, 0x83, 0xf0, 0x9f, 0xaa, 0xb9, 0xf0, 0x9f, 0xaa, 0xba, 0xf0, 0x9f, 0x8d, 0x84, 0xf0, 0x9f, 0x8d, 0x87, 0xf0, 0x9f, 0x8d, 0x88, 0xf0, 0x9f, 0x8d, 0x89, 0xf0, 0x9f, 0x8d, 0x8a, 0xf0, 0x9f, 0x8d, 0x8a, 0xf0, 0x9f, 0x8d, 0x8a, 0xf0, 0x9f, 0x8d, 0x8b, 0xf0, 0x9f, 0x8d, 0x8c, 0xf0, 0x9f, 0x8d, 0x8d, 0xf0, 0x9f, 0xa5, 0xad, 0xf0, 0x9f, 0x8d, 0x8e, 0xf0, 0x9f, 0x8d, 0x8f, 0xf0, 0x9f, 0x8d, 0x90, 0xf0, 0x9f, 0x8d, 0x91, 0xf0, 0x9f, 0x8d, 0x92, 0xf0, 0x9f, 0x8d, 0x93, 0xf0, 0x9f, 0xab, 0x90, 0xf0, 0x9f, 0xa5, 0x9d, 0xf0, 0x9f, 0x8d, 0x85, 0xf0, 0x9f, 0xab, 0x92, 0xf0, 0x9f, 0xa5, 0xa5, 0xf0, 0x9f, 0xa5, 0x91, 0xf0, 0x9f, 0x8d, 0x86, 0xf0, 0x9f, 0xa5, 0x94, 0xf0, 0x9f, 0xa5, 0x95, 0xf0, 0x9f, 0x8c, 0xbd, 0xf0, 0x9f, 0x8c, 0xb6, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xab, 0x91, 0xf0, 0x9f, 0xa5, 0x92, 0xf0, 0x9f, 0xa5, 0xac, 0xf0, 0x9f, 0xa5, 0xa6, 0xf0, 0x9f, 0xa7, 0x84, 0xf0, 0x9f, 0xa7, 0x85, 0xf0, 0x9f, 0xa5, 0x9c, 0xf0, 0x9f, 0xab, 0x98, 0xf0, 0x9f, 0x8c, 0xb0, 0xf0, 0x9f, 0xab, 0x9a, 0xf0, 0x9f, 0xab, 0x9b, 0xf0, 0x9f, 0x8d, 0x9e, 0xf0, 0x9f, 0xa5, 0x90, 0xf0, 0x9f, 0xa5, 0x96, 0xf0, 0x9f, 0xab, 0x93, 0xf0, 0x9f, 0xa5, 0xa8, 0xf0, 0x9f, 0xa5, 0xaf, 0xf0, 0x9f, 0xa5, 0x9e, 0xf0, 0x9f, 0xa7, 0x87, 0xf0, 0x9f, 0xa7, 0x80, 0xf0, 0x9f, 0x8d, 0x96, 0xf0, 0x9f, 0x8d, 0x97, 0xf0, 0x9f, 0xa5, 0xa9, 0xf0, 0x9f, 0xa5, 0x93, 0xf0, 0x9f, 0x8d, 0x94, 0xf0, 0x9f, 0x8d, 0x9f, 0xf0, 0x9f, 0x8d, 0x95, 0xf0, 0x9f, 0x8c, 0xad, 0xf0, 0x9f, 0xa5, 0xaa, 0xf0, 0x9f, 0x8c, 0xae, 0xf0, 0x9f, 0x8c, 0xaf, 0xf0, 0x9f, 0xab, 0x94, 0xf0, 0x9f, 0xa5, 0x99, 0xf0, 0x9f, 0xa7, 0x86, 0xf0, 0x9f, 0xa5, 0x9a, 0xf0, 0x9f, 0x8d, 0xb3, 0xf0, 0x9f, 0xa5, 0x98, 0xf0, 0x9f, 0x8d, 0xb2, 0xf0, 0x9f, 0xab, 0x95, 0xf0, 0x9f, 0xa5, 0xa3, 0xf0, 0x9f, 0xa5, 0x97, 0xf0, 0x9f, 0x8d, 0xbf, 0xf0, 0x9f, 0xa7, 0x88, 0xf0, 0x9f, 0xa7, 0x82, 0xf0, 0x9f, 0xa5, 0xab, 0xf0, 0x9f, 0x8d, 0xb1, 0xf0, 0x9f, 0x8d, 0x98, 0xf0, 0x9f, 0x8d, 0x99, 0xf0, 0x9f, 0x8d, 0x9a, 0xf0, 0x9f, 0x8d, 0x9b, 0xf0, 0x9f, 0x8d, 0x9c, 0xf0, 0x9f, 0x8d, 0x9d, 0xf0, 0x9f, 0x8d, 0xa0, 0xf0, 0x9f, 0x8d, 0xa2, 0xf0, 0x9f, 0x8d, 0xa3, 0xf0, 0x9f, 0x8d, 0xa4, 0xf0, 0x9f, 0x8d, 0xa5, 0xf0, 0x9f, 0xa5, 0xae, 0xf0, 0x9f, 0x8d, 0xa1, 0xf0, 0x9f, 0xa5, 0x9f, 0xf0, 0x9f, 0xa5, 0xa0, 0xf0, 0x9f, 0xa5, 0xa1, 0xf0, 0x9f, 0xa6, 0x80, 0xf0, 0x9f, 0xa6, 0x9e, 0xf0, 0x9f, 0xa6, 0x90, 0xf0, 0x9f, 0xa6, 0x91, 0xf0, 0x9f, 0xa6, 0xaa, 0xf0, 0x9f, 0x8d, 0xa6, 0xf0, 0x9f, 0x8d, 0xa7, 0xf0, 0x9f, 0x8d, 0xa8, 0xf0, 0x9f, 0x8d, 0xa9, 0xf0, 0x9f, 0x8d, 0xaa, 0xf0, 0x9f, 0x8e, 0x82, 0xf0, 0x9f, 0x8d, 0xb0, 0xf0, 0x9f, 0xa7, 0x81, 0xf0, 0x9f, 0xa5, 0xa7, 0xf0, 0x9f, 0x8d, 0xab, 0xf0, 0x9f, 0x8d, 0xac, 0xf0, 0x9f, 0x8d, 0xad, 0xf0, 0x9f, 0x8d, 0xae, 0xf0, 0x9f, 0x8d, 0xaf, 0xf0, 0x9f, 0x8d, 0xbc, 0xf0, 0x9f, 0xa5, 0x9b, 0xe2, 0x98, 0x95, 0xf0, 0x9f, 0xab, 0x96, 0xf0, 0x9f, 0x8d, 0xb5, 0xf0, 0x9f, 0x8d, 0xb6, 0xf0, 0x9f, 0x8d, 0xbe, 0xf0, 0x9f, 0x8d, 0xb7, 0xf0, 0x9f, 0x8d, 0xb8, 0xf0, 0x9f, 0x8d, 0xb9, 0xf0, 0x9f, 0x8d, 0xba, 0xf0, 0x9f, 0x8d, 0xbb, 0xf0, 0x9f, 0xa5, 0x82, 0xf0, 0x9f, 0xa5, 0x83, 0xf0, 0x9f, 0xab, 0x97, 0xf0, 0x9f, 0xa5, 0xa4, 0xf0, 0x9f, 0xa7, 0x8b, 0xf0, 0x9f, 0xa7, 0x83, 0xf0, 0x9f, 0xa7, 0x89, 0xf0, 0x9f, 0xa7, 0x8a, 0xf0, 0x9f, 0xa5, 0xa2, 0xf0, 0x9f, 0x8d, 0xbd, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8d, 0xb4, 0xf0, 0x9f, 0xa5, 0x84, 0xf0, 0x9f, 0x94, 0xaa, 0xf0, 0x9f, 0x94, 0xaa, 0xf0, 0x9f, 0xab, 0x99, 0xf0, 0x9f, 0x8f, 0xba, 0xf0, 0x9f, 0x8c, 0x8d, 0xf0, 0x9f, 0x8c, 0x8e, 0xf0, 0x9f, 0x8c, 0x8f, 0xf0, 0x9f, 0x8c, 0x90, 0xf0, 0x9f, 0x97, 0xba, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x97, 0xbe, 0xf0, 0x9f, 0xa7, 0xad, 0xf0, 0x9f, 0x8f, 0x94, 0xef, 0xb8, 0x8f, 0xe2, 0x9b, 0xb0, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8c, 0x8b, 0xf0, 0x9f, 0x97, 0xbb, 0xf0, 0x9f, 0x8f, 0x95, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x96, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x9c, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x9d, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x9e, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x9f, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x9b, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x97, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0xb1, 0xf0, 0x9f, 0xaa, 0xa8, 0xf0, 0x9f, 0xaa, 0xb5, 0xf0, 0x9f, 0x9b, 0x96, 0xf0, 0x9f, 0x8f, 0x98, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x9a, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0xa0, 0xf0, 0x9f, 0x8f, 0xa1, 0xf0, 0x9f, 0x8f, 0xa2, 0xf0, 0x9f, 0x8f, 0xa3, 0xf0, 0x9f, 0x8f, 0xa4, 0xf0, 0x9f, 0x8f, 0xa5, 0xf0, 0x9f, 0x8f, 0xa6, 0xf0, 0x9f, 0x8f, 0xa8, 0xf0, 0x9f, 0x8f, 0xa9, 0xf0, 0x9f, 0x8f, 0xaa, 0xf0, 0x9f, 0x8f, 0xab, 0xf0, 0x9f, 0x8f, 0xac, 0xf0, 0x9f, 0x8f, 0xad, 0xf0, 0x9f, 0x8f, 0xaf, 0xf0, 0x9f, 0x8f, 0xb0, 0xf0, 0x9f, 0x92, 0x92, 0xf0, 0x9f, 0x97, 0xbc, 0xf0, 0x9f, 0x97, 0xbd, 0xe2, 0x9b, 0xaa, 0xf0, 0x9f, 0x95, 0x8c, 0xf0, 0x9f, 0x9b, 0x95, 0xf0, 0x9f, 0x95, 0x8d, 0xe2, 0x9b, 0xa9, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x95, 0x8b, 0xe2, 0x9b, 0xb2, 0xe2, 0x9b, 0xba, 0xf0, 0x9f, 0x8c, 0x81, 0xf0, 0x9f, 0x8c, 0x83, 0xf0, 0x9f, 0x8f, 0x99, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8c, 0x84, 0xf0, 0x9f, 0x8c, 0x85, 0xf0, 0x9f, 0x8c, 0x86, 0xf0, 0x9f, 0x8c, 0x87, 0xf0, 0x9f, 0x8c, 0x89, 0xe2, 0x99, 0xa8, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8e, 0xa0, 0xf0, 0x9f, 0x9b, 0x9d, 0xf0, 0x9f, 0x8e, 0xa1, 0xf0, 0x9f, 0x8e, 0xa2, 0xf0, 0x9f, 0x92, 0x88, 0xf0, 0x9f, 0x8e, 0xaa, 0xf0, 0x9f, 0x9a, 0x82, 0xf0, 0x9f, 0x9a, 0x83, 0xf0, 0x9f, 0x9a, 0x84, 0xf0, 0x9f, 0x9a, 0x85, 0xf0, 0x9f, 0x9a, 0x86, 0xf0, 0x9f, 0x9a, 0x87, 0xf0, 0x9f, 0x9a, 0x88, 0xf0, 0x9f, 0x9a, 0x89, 0xf0, 0x9f, 0x9a, 0x8a, 0xf0, 0x9f, 0x9a, 0x9d, 0xf0, 0x9f, 0x9a, 0x9e, 0xf0, 0x9f, 0x9a, 0x8b, 0xf0, 0x9f, 0x9a, 0x8c, 0xf0, 0x9f, 0x9a, 0x8d, 0xf0, 0x9f, 0x9a, 0x8e, 0xf0, 0x9f, 0x9a, 0x90, 0xf0, 0x9f, 0x9a, 0x91, 0xf0, 0x9f, 0x9a, 0x92, 0xf0, 0x9f, 0x9a, 0x93, 0xf0, 0x9f, 0x9a, 0x94, 0xf0, 0x9f, 0x9a, 0x95, 0xf0, 0x9f, 0x9a, 0x96, 0xf0, 0x9f, 0x9a, 0x97, 0xf0, 0x9f, 0x9a, 0x97, 0xf0, 0x9f, 0x9a, 0x98, 0xf0, 0x9f, 0x9a, 0x99, 0xf0, 0x9f, 0x9b, 0xbb, 0xf0, 0x9f, 0x9a, 0x9a, 0xf0, 0x9f, 0x9a, 0x9b, 0xf0, 0x9f, 0x9a, 0x9c, 0xf0, 0x9f, 0x8f, 0x8e, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x8d, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x9b, 0xb5, 0xf0, 0x9f, 0xa6, 0xbd, 0xf0, 0x9f, 0xa6, 0xbc, 0xf0, 0x9f, 0x9b, 0xba, 0xf0, 0x9f, 0x9a, 0xb2, 0xf0, 0x9f, 0x9b, 0xb4, 0xf0, 0x9f, 0x9b, 0xb9, 0xf0, 0x9f, 0x9b, 0xbc, 0xf0, 0x9f, 0x9a, 0x8f, 0xf0, 0x9f, 0x9b, 0xa3, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x9b, 0xa4, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x9b, 0xa2, 0xef, 0xb8, 0x8f, 0xe2, 0x9b, 0xbd, 0xf0, 0x9f, 0x9b, 0x9e, 0xf0, 0x9f, 0x9a, 0xa8, 0xf0, 0x9f, 0x9a, 0xa5, 0xf0, 0x9f, 0x9a, 0xa6, 0xf0, 0x9f, 0x9b, 0x91, 0xf0, 0x9f, 0x9a, 0xa7, 0xe2, 0x9a, 0x93, 0xf0, 0x9f, 0x9b, 0x9f, 0xe2, 0x9b, 0xb5, 0xe2, 0x9b, 0xb5, 0xf0, 0x9f, 0x9b, 0xb6, 0xf0, 0x9f, 0x9a, 0xa4, 0xf0, 0x9f, 0x9b, 0xb3, 0xef, 0xb8, 0x8f, 0xe2, 0x9b, 0xb4, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x9b, 0xa5, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x9a, 0xa2, 0xe2, 0x9c, 0x88, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x9b, 0xa9, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x9b, 0xab, 0xf0, 0x9f, 0x9b, 0xac, 0xf0, 0x9f, 0xaa, 0x82, 0xf0, 0x9f, 0x92, 0xba, 0xf0, 0x9f, 0x9a, 0x81, 0xf0, 0x9f, 0x9a, 0x9f, 0xf0, 0x9f, 0x9a, 0xa0, 0xf0, 0x9f, 0x9a, 0xa1, 0xf0, 0x9f, 0x9b, 0xb0, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x9a, 0x80, 0xf0, 0x9f, 0x9b, 0xb8, 0xf0, 0x9f, 0x9b, 0x8e, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0xb3, 0xe2, 0x8c, 0x9b, 0xe2, 0x8f, 0xb3, 0xe2, 0x8c, 0x9a, 0xe2, 0x8f, 0xb0, 0xe2, 0x8f, 0xb1, 0xef, 0xb8, 0x8f, 0xe2, 0x8f, 0xb2, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x95, 0xb0, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x95, 0x9b, 0xf0, 0x9f, 0x95, 0xa7, 0xf0, 0x9f, 0x95, 0x90, 0xf0, 0x9f, 0x95, 0x9c, 0xf0, 0x9f, 0x95, 0x91, 0xf0, 0x9f, 0x95, 0x9d, 0xf0, 0x9f, 0x95, 0x92, 0xf0, 0x9f, 0x95, 0x9e, 0xf0, 0x9f, 0x95, 0x93, 0xf0, 0x9f, 0x95, 0x9f, 0xf0, 0x9f, 0x95, 0x94, 0xf0, 0x9f, 0x95, 0xa0, 0xf0, 0x9f, 0x95, 0x95, 0xf0, 0x9f, 0x95, 0xa1, 0xf0, 0x9f, 0x95, 0x96, 0xf0, 0x9f, 0x95, 0xa2, 0xf0, 0x9f, 0x95, 0x97, 0xf0, 0x9f, 0x95, 0xa3, 0xf0, 0x9f, 0x95, 0x98, 0xf0, 0x9f, 0x95, 0xa4, 0xf0, 0x9f, 0x95, 0x99, 0xf0, 0x9f, 0x95, 0xa5, 0xf0, 0x9f, 0x95, 0x9a, 0xf0, 0x9f, 0x95, 0xa6, 0xf0, 0x9f, 0x8c, 0x91, 0xf0, 0x9f, 0x8c, 0x92, 0xf0, 0x9f, 0x8c, 0x93, 0xf0, 0x9f, 0x8c, 0x94, 0xf0, 0x9f, 0x8c, 0x94, 0xf0, 0x9f, 0x8c, 0x95, 0xf0, 0x9f, 0x8c, 0x96, 0xf0, 0x9f, 0x8c, 0x97, 0xf0, 0x9f, 0x8c, 0x98, 0xf0, 0x9f, 0x8c, 0x99, 0xf0, 0x9f, 0x8c, 0x9a, 0xf0, 0x9f, 0x8c, 0x9b, 0xf0, 0x9f, 0x8c, 0x9c, 0xf0, 0x9f, 0x8c, 0xa1, 0xef, 0xb8, 0x8f, 0xe2, 0x98, 0x80, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8c, 0x9d, 0xf0, 0x9f, 0x8c, 0x9e, 0xf0, 0x9f, 0xaa, 0x90, 0xe2, 0xad, 0x90, 0xf0, 0x9f, 0x8c, 0x9f, 0xf0, 0x9f, 0x8c, 0xa0, 0xf0, 0x9f, 0x8c, 0x8c, 0xe2, 0x98, 0x81, 0xef, 0xb8, 0x8f, 0xe2, 0x9b, 0x85, 0xe2, 0x9b, 0x88, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8c, 0xa4, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8c, 0xa5, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8c, 0xa6, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8c, 0xa7, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8c, 0xa8, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8c, 0xa9, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8c, 0xaa, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8c, 0xab, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8c, 0xac, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8c, 0x80, 0xf0, 0x9f, 0x8c, 0x88, 0xf0, 0x9f, 0x8c, 0x82, 0xe2, 0x98, 0x82, 0xef, 0xb8, 0x8f, 0xe2, 0x98, 0x94, 0xe2, 0x9b, 0xb1, 0xef, 0xb8, 0x8f, 0xe2, 0x9a, 0xa1, 0xe2, 0x9d, 0x84, 0xef, 0xb8, 0x8f, 0xe2, 0x98, 0x83, 0xef, 0xb8, 0x8f, 0xe2, 0x9b, 0x84, 0xe2, 0x98, 0x84, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x94, 0xa5, 0xf0, 0x9f, 0x92, 0xa7, 0xf0, 0x9f, 0x8c, 0x8a, 0xf0, 0x9f, 0x8e, 0x83, 0xf0, 0x9f, 0x8e, 0x84, 0xf0, 0x9f, 0x8e, 0x86, 0xf0, 0x9f, 0x8e, 0x87, 0xf0, 0x9f, 0xa7, 0xa8, 0xe2, 0x9c, 0xa8, 0xf0, 0x9f, 0x8e, 0x88, 0xf0, 0x9f, 0x8e, 0x89, 0xf0, 0x9f, 0x8e, 0x8a, 0xf0, 0x9f, 0x8e, 0x8b, 0xf0, 0x9f, 0x8e, 0x8d, 0xf0, 0x9f, 0x8e, 0x8e, 0xf0, 0x9f, 0x8e, 0x8f, 0xf0, 0x9f, 0x8e, 0x90, 0xf0, 0x9f, 0x8e, 0x91, 0xf0, 0x9f, 0xa7, 0xa7, 0xf0, 0x9f, 0x8e, 0x80, 0xf0, 0x9f, 0x8e, 0x81, 0xf0, 0x9f, 0x8e, 0x97, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8e, 0x9f, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8e, 0xab, 0xf0, 0x9f, 0x8e, 0x96, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0x86, 0xf0, 0x9f, 0x8f, 0x85, 0xf0, 0x9f, 0xa5, 0x87, 0xf0, 0x9f, 0xa5, 0x88, 0xf0, 0x9f, 0xa5, 0x89, 0xe2, 0x9a, 0xbd, 0xe2, 0x9a, 0xbe, 0xf0, 0x9f, 0xa5, 0x8e, 0xf0, 0x9f, 0x8f, 0x80, 0xf0, 0x9f, 0x8f, 0x90, 0xf0, 0x9f, 0x8f, 0x88, 0xf0, 0x9f, 0x8f, 0x89, 0xf0, 0x9f, 0x8e, 0xbe, 0xf0, 0x9f, 0xa5, 0x8f, 0xf0, 0x9f, 0x8e, 0xb3, 0xf0, 0x9f, 0x8f, 0x8f, 0xf0, 0x9f, 0x8f, 0x91, 0xf0, 0x9f, 0x8f, 0x92, 0xf0, 0x9f, 0xa5, 0x8d, 0xf0, 0x9f, 0x8f, 0x93, 0xf0, 0x9f, 0x8f, 0xb8, 0xf0, 0x9f, 0xa5, 0x8a, 0xf0, 0x9f, 0xa5, 0x8b, 0xf0, 0x9f, 0xa5, 0x85, 0xe2, 0x9b, 0xb3, 0xe2, 0x9b, 0xb8, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8e, 0xa3, 0xf0, 0x9f, 0xa4, 0xbf, 0xf0, 0x9f, 0x8e, 0xbd, 0xf0, 0x9f, 0x8e, 0xbf, 0xf0, 0x9f, 0x9b, 0xb7, 0xf0, 0x9f, 0xa5, 0x8c, 0xf0, 0x9f, 0x8e, 0xaf, 0xf0, 0x9f, 0xaa, 0x80, 0xf0, 0x9f, 0xaa, 0x81, 0xf0, 0x9f, 0x94, 0xab, 0xf0, 0x9f, 0x8e, 0xb1, 0xf0, 0x9f, 0x94, 0xae, 0xf0, 0x9f, 0xaa, 0x84, 0xf0, 0x9f, 0x8e, 0xae, 0xf0, 0x9f, 0x95, 0xb9, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8e, 0xb0, 0xf0, 0x9f, 0x8e, 0xb2, 0xf0, 0x9f, 0xa7, 0xa9, 0xf0, 0x9f, 0xa7, 0xb8, 0xf0, 0x9f, 0xaa, 0x85, 0xf0, 0x9f, 0xaa, 0xa9, 0xf0, 0x9f, 0xaa, 0x86, 0xe2, 0x99, 0xa0, 0xef, 0xb8, 0x8f, 0xe2, 0x99, 0xa5, 0xef, 0xb8, 0x8f, 0xe2, 0x99, 0xa6, 0xef, 0xb8, 0x8f, 0xe2, 0x99, 0xa3, 0xef, 0xb8, 0x8f, 0xe2, 0x99, 0x9f, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x83, 0x8f, 0xf0, 0x9f, 0x80, 0x84, 0xf0, 0x9f, 0x8e, 0xb4, 0xf0, 0x9f, 0x8e, 0xad, 0xf0, 0x9f, 0x96, 0xbc, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8e, 0xa8, 0xf0, 0x9f, 0xa7, 0xb5, 0xf0, 0x9f, 0xaa, 0xa1, 0xf0, 0x9f, 0xa7, 0xb6, 0xf0, 0x9f, 0xaa, 0xa2, 0xf0, 0x9f, 0x91, 0x93, 0xf0, 0x9f, 0x95, 0xb6, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa5, 0xbd, 0xf0, 0x9f, 0xa5, 0xbc, 0xf0, 0x9f, 0xa6, 0xba, 0xf0, 0x9f, 0x91, 0x94, 0xf0, 0x9f, 0x91, 0x95, 0xf0, 0x9f, 0x91, 0x95, 0xf0, 0x9f, 0x91, 0x96, 0xf0, 0x9f, 0xa7, 0xa3, 0xf0, 0x9f, 0xa7, 0xa4, 0xf0, 0x9f, 0xa7, 0xa5, 0xf0, 0x9f, 0xa7, 0xa6, 0xf0, 0x9f, 0x91, 0x97, 0xf0, 0x9f, 0x91, 0x98, 0xf0, 0x9f, 0xa5, 0xbb, 0xf0, 0x9f, 0xa9, 0xb1, 0xf0, 0x9f, 0xa9, 0xb2, 0xf0, 0x9f, 0xa9, 0xb3, 0xf0, 0x9f, 0x91, 0x99, 0xf0, 0x9f, 0x91, 0x9a, 0xf0, 0x9f, 0xaa, 0xad, 0xf0, 0x9f, 0x91, 0x9b, 0xf0, 0x9f, 0x91, 0x9c, 0xf0, 0x9f, 0x91, 0x9d, 0xf0, 0x9f, 0x9b, 0x8d, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8e, 0x92, 0xf0, 0x9f, 0xa9, 0xb4, 0xf0, 0x9f, 0x91, 0x9e, 0xf0, 0x9f, 0x91, 0x9e, 0xf0, 0x9f, 0x91, 0x9f, 0xf0, 0x9f, 0xa5, 0xbe, 0xf0, 0x9f, 0xa5, 0xbf, 0xf0, 0x9f, 0x91, 0xa0, 0xf0, 0x9f, 0x91, 0xa1, 0xf0, 0x9f, 0xa9, 0xb0, 0xf0, 0x9f, 0x91, 0xa2, 0xf0, 0x9f, 0xaa, 0xae, 0xf0, 0x9f, 0x91, 0x91, 0xf0, 0x9f, 0x91, 0x92, 0xf0, 0x9f, 0x8e, 0xa9, 0xf0, 0x9f, 0x8e, 0x93, 0xf0, 0x9f, 0xa7, 0xa2, 0xf0, 0x9f, 0xaa, 0x96, 0xe2, 0x9b, 0x91, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x93, 0xbf, 0xf0, 0x9f, 0x92, 0x84, 0xf0, 0x9f, 0x92, 0x8d, 0xf0, 0x9f, 0x92, 0x8e, 0xf0, 0x9f, 0x94, 0x87, 0xf0, 0x9f, 0x94, 0x88, 0xf0, 0x9f, 0x94, 0x89, 0xf0, 0x9f, 0x94, 0x8a, 0xf0, 0x9f, 0x93, 0xa2, 0xf0, 0x9f, 0x93, 0xa3, 0xf0, 0x9f, 0x93, 0xaf, 0xf0, 0x9f, 0x94, 0x94, 0xf0, 0x9f, 0x94, 0x95, 0xf0, 0x9f, 0x8e, 0xbc, 0xf0, 0x9f, 0x8e, 0xb5, 0xf0, 0x9f, 0x8e, 0xb6, 0xf0, 0x9f, 0x8e, 0x99, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8e, 0x9a, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8e, 0x9b, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8e, 0xa4, 0xf0, 0x9f, 0x8e, 0xa7, 0xf0, 0x9f, 0x93, 0xbb, 0xf0, 0x9f, 0x8e, 0xb7, 0xf0, 0x9f, 0xaa, 0x97, 0xf0, 0x9f, 0x8e, 0xb8, 0xf0, 0x9f, 0x8e, 0xb9, 0xf0, 0x9f, 0x8e, 0xba, 0xf0, 0x9f, 0x8e, 0xbb, 0xf0, 0x9f, 0xaa, 0x95, 0xf0, 0x9f, 0xa5, 0x81, 0xf0, 0x9f, 0xaa, 0x98, 0xf0, 0x9f, 0xaa, 0x87, 0xf0, 0x9f, 0xaa, 0x88, 0xf0, 0x9f, 0x93, 0xb1, 0xf0, 0x9f, 0x93, 0xb2, 0xe2, 0x98, 0x8e, 0xef, 0xb8, 0x8f, 0xe2, 0x98, 0x8e, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x93, 0x9e, 0xf0, 0x9f, 0x93, 0x9f, 0xf0, 0x9f, 0x93, 0xa0, 0xf0, 0x9f, 0x94, 0x8b, 0xf0, 0x9f, 0xaa, 0xab, 0xf0, 0x9f, 0x94, 0x8c, 0xf0, 0x9f, 0x92, 0xbb, 0xf0, 0x9f, 0x96, 0xa5, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x96, 0xa8, 0xef, 0xb8, 0x8f, 0xe2, 0x8c, 0xa8, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x96, 0xb1, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x96, 0xb2, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x92, 0xbd, 0xf0, 0x9f, 0x92, 0xbe, 0xf0, 0x9f, 0x92, 0xbf, 0xf0, 0x9f, 0x93, 0x80, 0xf0, 0x9f, 0xa7, 0xae, 0xf0, 0x9f, 0x8e, 0xa5, 0xf0, 0x9f, 0x8e, 0x9e, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x93, 0xbd, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8e, 0xac, 0xf0, 0x9f, 0x93, 0xba, 0xf0, 0x9f, 0x93, 0xb7, 0xf0, 0x9f, 0x93, 0xb8, 0xf0, 0x9f, 0x93, 0xb9, 0xf0, 0x9f, 0x93, 0xbc, 0xf0, 0x9f, 0x94, 0x8d, 0xf0, 0x9f, 0x94, 0x8e, 0xf0, 0x9f, 0x95, 0xaf, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x92, 0xa1, 0xf0, 0x9f, 0x94, 0xa6, 0xf0, 0x9f, 0x8f, 0xae, 0xf0, 0x9f, 0x8f, 0xae, 0xf0, 0x9f, 0xaa, 0x94, 0xf0, 0x9f, 0x93, 0x94, 0xf0, 0x9f, 0x93, 0x95, 0xf0, 0x9f, 0x93, 0x96, 0xf0, 0x9f, 0x93, 0x96, 0xf0, 0x9f, 0x93, 0x97, 0xf0, 0x9f, 0x93, 0x98, 0xf0, 0x9f, 0x93, 0x99, 0xf0, 0x9f, 0x93, 0x9a, 0xf0, 0x9f, 0x93, 0x93, 0xf0, 0x9f, 0x93, 0x92, 0xf0, 0x9f, 0x93, 0x83, 0xf0, 0x9f, 0x93, 0x9c, 0xf0, 0x9f, 0x93, 0x84, 0xf0, 0x9f, 0x93, 0xb0, 0xf0, 0x9f, 0x97, 0x9e, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x93, 0x91, 0xf0, 0x9f, 0x94, 0x96, 0xf0, 0x9f, 0x8f, 0xb7, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x92, 0xb0, 0xf0, 0x9f, 0xaa, 0x99, 0xf0, 0x9f, 0x92, 0xb4, 0xf0, 0x9f, 0x92, 0xb5, 0xf0, 0x9f, 0x92, 0xb6, 0xf0, 0x9f, 0x92, 0xb7, 0xf0, 0x9f, 0x92, 0xb8, 0xf0, 0x9f, 0x92, 0xb3, 0xf0, 0x9f, 0xa7, 0xbe, 0xf0, 0x9f, 0x92, 0xb9, 0xe2, 0x9c, 0x89, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x93, 0xa7, 0xf0, 0x9f, 0x93, 0xa7, 0xf0, 0x9f, 0x93, 0xa8, 0xf0, 0x9f, 0x93, 0xa9, 0xf0, 0x9f, 0x93, 0xa4, 0xf0, 0x9f, 0x93, 0xa5, 0xf0, 0x9f, 0x93, 0xa6, 0xf0, 0x9f, 0x93, 0xab, 0xf0, 0x9f, 0x93, 0xaa, 0xf0, 0x9f, 0x93, 0xac, 0xf0, 0x9f, 0x93, 0xad, 0xf0, 0x9f, 0x93, 0xae, 0xf0, 0x9f, 0x97, 0xb3, 0xef, 0xb8, 0x8f, 0xe2, 0x9c, 0x8f, 0xef, 0xb8, 0x8f, 0xe2, 0x9c, 0x92, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x96, 0x8b, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x96, 0x8a, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x96, 0x8c, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x96, 0x8d, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x93, 0x9d, 0xf0, 0x9f, 0x93, 0x9d, 0xf0, 0x9f, 0x92, 0xbc, 0xf0, 0x9f, 0x93, 0x81, 0xf0, 0x9f, 0x93, 0x82, 0xf0, 0x9f, 0x97, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x93, 0x85, 0xf0, 0x9f, 0x93, 0x86, 0xf0, 0x9f, 0x97, 0x92, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x97, 0x93, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x93, 0x87, 0xf0, 0x9f, 0x93, 0x88, 0xf0, 0x9f, 0x93, 0x89, 0xf0, 0x9f, 0x93, 0x8a, 0xf0, 0x9f, 0x93, 0x8b, 0xf0, 0x9f, 0x93, 0x8c, 0xf0, 0x9f, 0x93, 0x8d, 0xf0, 0x9f, 0x93, 0x8e, 0xf0, 0x9f, 0x96, 0x87, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x93, 0x8f, 0xf0, 0x9f, 0x93, 0x90, 0xe2, 0x9c, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x97, 0x83, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x97, 0x84, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x97, 0x91, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x94, 0x92, 0xf0, 0x9f, 0x94, 0x93, 0xf0, 0x9f, 0x94, 0x8f, 0xf0, 0x9f, 0x94, 0x90, 0xf0, 0x9f, 0x94, 0x91, 0xf0, 0x9f, 0x97, 0x9d, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x94, 0xa8, 0xf0, 0x9f, 0xaa, 0x93, 0xe2, 0x9b, 0x8f, 0xef, 0xb8, 0x8f, 0xe2, 0x9a, 0x92, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x9b, 0xa0, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x97, 0xa1, 0xef, 0xb8, 0x8f, 0xe2, 0x9a, 0x94, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x92, 0xa3, 0xf0, 0x9f, 0xaa, 0x83, 0xf0, 0x9f, 0x8f, 0xb9, 0xf0, 0x9f, 0x9b, 0xa1, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xaa, 0x9a, 0xf0, 0x9f, 0x94, 0xa7, 0xf0, 0x9f, 0xaa, 0x9b, 0xf0, 0x9f, 0x94, 0xa9, 0xe2, 0x9a, 0x99, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x97, 0x9c, 0xef, 0xb8, 0x8f, 0xe2, 0x9a, 0x96, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa6, 0xaf, 0xf0, 0x9f, 0x94, 0x97, 0xe2, 0x9b, 0x93, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xaa, 0x9d, 0xf0, 0x9f, 0xa7, 0xb0, 0xf0, 0x9f, 0xa7, 0xb2, 0xf0, 0x9f, 0xaa, 0x9c, 0xe2, 0x9a, 0x97, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0xaa, 0xf0, 0x9f, 0xa7, 0xab, 0xf0, 0x9f, 0xa7, 0xac, 0xf0, 0x9f, 0x94, 0xac, 0xf0, 0x9f, 0x94, 0xad, 0xf0, 0x9f, 0x93, 0xa1, 0xf0, 0x9f, 0x92, 0x89, 0xf0, 0x9f, 0xa9, 0xb8, 0xf0, 0x9f, 0x92, 0x8a, 0xf0, 0x9f, 0xa9, 0xb9, 0xf0, 0x9f, 0xa9, 0xbc, 0xf0, 0x9f, 0xa9, 0xba, 0xf0, 0x9f, 0xa9, 0xbb, 0xf0, 0x9f, 0x9a, 0xaa, 0xf0, 0x9f, 0x9b, 0x97, 0xf0, 0x9f, 0xaa, 0x9e, 0xf0, 0x9f, 0xaa, 0x9f, 0xf0, 0x9f, 0x9b, 0x8f, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x9b, 0x8b, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xaa, 0x91, 0xf0, 0x9f, 0x9a, 0xbd, 0xf0, 0x9f, 0xaa, 0xa0, 0xf0, 0x9f, 0x9a, 0xbf, 0xf0, 0x9f, 0x9b, 0x81, 0xf0, 0x9f, 0xaa, 0xa4, 0xf0, 0x9f, 0xaa, 0x92, 0xf0, 0x9f, 0xa7, 0xb4, 0xf0, 0x9f, 0xa7, 0xb7, 0xf0, 0x9f, 0xa7, 0xb9, 0xf0, 0x9f, 0xa7, 0xba, 0xf0, 0x9f, 0xa7, 0xbb, 0xf0, 0x9f, 0xaa, 0xa3, 0xf0, 0x9f, 0xa7, 0xbc, 0xf0, 0x9f, 0xab, 0xa7, 0xf0, 0x9f, 0xaa, 0xa5, 0xf0, 0x9f, 0xa7, 0xbd, 0xf0, 0x9f, 0xa7, 0xaf, 0xf0, 0x9f, 0x9b, 0x92, 0xf0, 0x9f, 0x9a, 0xac, 0xe2, 0x9a, 0xb0, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xaa, 0xa6, 0xe2, 0x9a, 0xb1, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0xa7, 0xbf, 0xf0, 0x9f, 0xaa, 0xac, 0xf0, 0x9f, 0x97, 0xbf, 0xf0, 0x9f, 0xaa, 0xa7, 0xf0, 0x9f, 0xaa, 0xaa, 0xf0, 0x9f, 0x8f, 0xa7, 0xf0, 0x9f, 0x9a, 0xae, 0xf0, 0x9f, 0x9a, 0xb0, 0xe2, 0x99, 0xbf, 0xf0, 0x9f, 0x9a, 0xb9, 0xf0, 0x9f, 0x9a, 0xba, 0xf0, 0x9f, 0x9a, 0xbb, 0xf0, 0x9f, 0x9a, 0xbc, 0xf0, 0x9f, 0x9a, 0xbe, 0xf0, 0x9f, 0x9b, 0x82, 0xf0, 0x9f, 0x9b, 0x83, 0xf0, 0x9f, 0x9b, 0x84, 0xf0, 0x9f, 0x9b, 0x85, 0xe2, 0x9a, 0xa0, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x9a, 0xb8, 0xe2, 0x9b, 0x94, 0xf0, 0x9f, 0x9a, 0xab, 0xf0, 0x9f, 0x9a, 0xb3, 0xf0, 0x9f, 0x9a, 0xad, 0xf0, 0x9f, 0x9a, 0xaf, 0xf0, 0x9f, 0x9a, 0xb1, 0xf0, 0x9f, 0x9a, 0xb7, 0xf0, 0x9f, 0x93, 0xb5, 0xf0, 0x9f, 0x94, 0x9e, 0xe2, 0x98, 0xa2, 0xef, 0xb8, 0x8f, 0xe2, 0x98, 0xa3, 0xef, 0xb8, 0x8f, 0xe2, 0xac, 0x86, 0xef, 0xb8, 0x8f, 0xe2, 0x86, 0x97, 0xef, 0xb8, 0x8f, 0xe2, 0x9e, 0xa1, 0xef, 0xb8, 0x8f, 0xe2, 0x86, 0x98, 0xef, 0xb8, 0x8f, 0xe2, 0xac, 0x87, 0xef, 0xb8, 0x8f, 0xe2, 0x86, 0x99, 0xef, 0xb8, 0x8f, 0xe2, 0xac, 0x85, 0xef, 0xb8, 0x8f, 0xe2, 0x86, 0x96, 0xef, 0xb8, 0x8f, 0xe2, 0x86, 0x95, 0xef, 0xb8, 0x8f, 0xe2, 0x86, 0x94, 0xef, 0xb8, 0x8f, 0xe2, 0x86, 0xa9, 0xef, 0xb8, 0x8f, 0xe2, 0x86, 0xaa, 0xef, 0xb8, 0x8f, 0xe2, 0xa4, 0xb4, 0xef, 0xb8, 0x8f, 0xe2, 0xa4, 0xb5, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x94, 0x83, 0xf0, 0x9f, 0x94, 0x84, 0xf0, 0x9f, 0x94, 0x99, 0xf0, 0x9f, 0x94, 0x9a, 0xf0, 0x9f, 0x94, 0x9b, 0xf0, 0x9f, 0x94, 0x9c, 0xf0, 0x9f, 0x94, 0x9d, 0xf0, 0x9f, 0x9b, 0x90, 0xe2, 0x9a, 0x9b, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x95, 0x89, 0xef, 0xb8, 0x8f, 0xe2, 0x9c, 0xa1, 0xef, 0xb8, 0x8f, 0xe2, 0x98, 0xb8, 0xef, 0xb8, 0x8f, 0xe2, 0x98, 0xaf, 0xef, 0xb8, 0x8f, 0xe2, 0x9c, 0x9d, 0xef, 0xb8, 0x8f, 0xe2, 0x98, 0xa6, 0xef, 0xb8, 0x8f, 0xe2, 0x98, 0xaa, 0xef, 0xb8, 0x8f, 0xe2, 0x98, 0xae, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x95, 0x8e, 0xf0, 0x9f, 0x94, 0xaf, 0xf0, 0x9f, 0xaa, 0xaf, 0xe2, 0x99, 0x88, 0xe2, 0x99, 0x89, 0xe2, 0x99, 0x8a, 0xe2, 0x99, 0x8b, 0xe2, 0x99, 0x8c, 0xe2, 0x99, 0x8d, 0xe2, 0x99, 0x8e, 0xe2, 0x99, 0x8f, 0xe2, 0x99, 0x90, 0xe2, 0x99, 0x91, 0xe2, 0x99, 0x92, 0xe2, 0x99, 0x93, 0xe2, 0x9b, 0x8e, 0xf0, 0x9f, 0x94, 0x80, 0xf0, 0x9f, 0x94, 0x81, 0xf0, 0x9f, 0x94, 0x82, 0xe2, 0x96, 0xb6, 0xef, 0xb8, 0x8f, 0xe2, 0x8f, 0xa9, 0xe2, 0x8f, 0xad, 0xef, 0xb8, 0x8f, 0xe2, 0x8f, 0xaf, 0xef, 0xb8, 0x8f, 0xe2, 0x97, 0x80, 0xef, 0xb8, 0x8f, 0xe2, 0x8f, 0xaa, 0xe2, 0x8f, 0xae, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x94, 0xbc, 0xe2, 0x8f, 0xab, 0xf0, 0x9f, 0x94, 0xbd, 0xe2, 0x8f, 0xac, 0xe2, 0x8f, 0xb8, 0xef, 0xb8, 0x8f, 0xe2, 0x8f, 0xb9, 0xef, 0xb8, 0x8f, 0xe2, 0x8f, 0xba, 0xef, 0xb8, 0x8f, 0xe2, 0x8f, 0x8f, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8e, 0xa6, 0xf0, 0x9f, 0x94, 0x85, 0xf0, 0x9f, 0x94, 0x86, 0xf0, 0x9f, 0x93, 0xb6, 0xf0, 0x9f, 0x9b, 0x9c, 0xf0, 0x9f, 0x93, 0xb3, 0xf0, 0x9f, 0x93, 0xb4, 0xe2, 0x99, 0x80, 0xef, 0xb8, 0x8f, 0xe2, 0x99, 0x82, 0xef, 0xb8, 0x8f, 0xe2, 0x9a, 0xa7, 0xef, 0xb8, 0x8f, 0xe2, 0x9c, 0x96, 0xef, 0xb8, 0x8f, 0xe2, 0x9e, 0x95, 0xe2, 0x9e, 0x96, 0xe2, 0x9e, 0x97, 0xf0, 0x9f, 0x9f, 0xb0, 0xe2, 0x99, 0xbe, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0xbc, 0xef, 0xb8, 0x8f, 0xe2, 0x81, 0x89, 0xef, 0xb8, 0x8f, 0xe2, 0x9d, 0x93, 0xe2, 0x9d, 0x94, 0xe2, 0x9d, 0x95, 0xe2, 0x9d, 0x97, 0xe2, 0x9d, 0x97, 0xe3, 0x80, 0xb0, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x92, 0xb1, 0xf0, 0x9f, 0x92, 0xb2, 0xe2, 0x9a, 0x95, 0xef, 0xb8, 0x8f, 0xe2, 0x99, 0xbb, 0xef, 0xb8, 0x8f, 0xe2, 0x9a, 0x9c, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x94, 0xb1, 0xf0, 0x9f, 0x93, 0x9b, 0xf0, 0x9f, 0x94, 0xb0, 0xe2, 0xad, 0x95, 0xe2, 0x9c, 0x85, 0xe2, 0x98, 0x91, 0xef, 0xb8, 0x8f, 0xe2, 0x9c, 0x94, 0xef, 0xb8, 0x8f, 0xe2, 0x9d, 0x8c, 0xe2, 0x9d, 0x8e, 0xe2, 0x9e, 0xb0, 0xe2, 0x9e, 0xbf, 0xe3, 0x80, 0xbd, 0xef, 0xb8, 0x8f, 0xe2, 0x9c, 0xb3, 0xef, 0xb8, 0x8f, 0xe2, 0x9c, 0xb4, 0xef, 0xb8, 0x8f, 0xe2, 0x9d, 0x87, 0xef, 0xb8, 0x8f, 0xc2, 0xa9, 0xef, 0xb8, 0x8f, 0xc2, 0xae, 0xef, 0xb8, 0x8f, 0xe2, 0x84, 0xa2, 0xef, 0xb8, 0x8f, 0x23, 0xef, 0xb8, 0x8f, 0xe2, 0x83, 0xa3, 0x2a, 0xef, 0xb8, 0x8f, 0xe2, 0x83, 0xa3, 0x30, 0xef, 0xb8, 0x8f, 0xe2, 0x83, 0xa3, 0x31, 0xef, 0xb8, 0x8f, 0xe2, 0x83, 0xa3, 0x32, 0xef, 0xb8, 0x8f, 0xe2, 0x83, 0xa3, 0x33, 0xef, 0xb8, 0x8f, 0xe2, 0x83, 0xa3, 0x34, 0xef, 0xb8, 0x8f, 0xe2, 0x83, 0xa3, 0x35, 0xef, 0xb8, 0x8f, 0xe2, 0x83, 0xa3, 0x36, 0xef, 0xb8, 0x8f, 0xe2, 0x83, 0xa3, 0x37, 0xef, 0xb8, 0x8f, 0xe2, 0x83, 0xa3, 0x38, 0xef, 0xb8, 0x8f, 0xe2, 0x83, 0xa3, 0x39, 0xef, 0xb8, 0x8f, 0xe2, 0x83, 0xa3, 0xf0, 0x9f, 0x94, 0x9f, 0xf0, 0x9f, 0x94, 0xa0, 0xf0, 0x9f, 0x94, 0xa1, 0xf0, 0x9f, 0x94, 0xa2, 0xf0, 0x9f, 0x94, 0xa3, 0xf0, 0x9f, 0x94, 0xa4, 0xf0, 0x9f, 0x85, 0xb0, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x86, 0x8e, 0xf0, 0x9f, 0x85, 0xb1, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x86, 0x91, 0xf0, 0x9f, 0x86, 0x92, 0xf0, 0x9f, 0x86, 0x93, 0xe2, 0x84, 0xb9, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x86, 0x94, 0xe2, 0x93, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x86, 0x95, 0xf0, 0x9f, 0x86, 0x96, 0xf0, 0x9f, 0x85, 0xbe, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x86, 0x97, 0xf0, 0x9f, 0x85, 0xbf, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x86, 0x98, 0xf0, 0x9f, 0x86, 0x99, 0xf0, 0x9f, 0x86, 0x9a, 0xf0, 0x9f, 0x88, 0x81, 0xf0, 0x9f, 0x88, 0x82, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x88, 0xb7, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x88, 0xb6, 0xf0, 0x9f, 0x88, 0xaf, 0xf0, 0x9f, 0x89, 0x90, 0xf0, 0x9f, 0x88, 0xb9, 0xf0, 0x9f, 0x88, 0x9a, 0xf0, 0x9f, 0x88, 0xb2, 0xf0, 0x9f, 0x89, 0x91, 0xf0, 0x9f, 0x88, 0xb8, 0xf0, 0x9f, 0x88, 0xb4, 0xf0, 0x9f, 0x88, 0xb3, 0xe3, 0x8a, 0x97, 0xef, 0xb8, 0x8f, 0xe3, 0x8a, 0x99, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x88, 0xba, 0xf0, 0x9f, 0x88, 0xb5, 0xf0, 0x9f, 0x94, 0xb4, 0xf0, 0x9f, 0x9f, 0xa0, 0xf0, 0x9f, 0x9f, 0xa1, 0xf0, 0x9f, 0x9f, 0xa2, 0xf0, 0x9f, 0x94, 0xb5, 0xf0, 0x9f, 0x9f, 0xa3, 0xf0, 0x9f, 0x9f, 0xa4, 0xe2, 0x9a, 0xab, 0xe2, 0x9a, 0xaa, 0xf0, 0x9f, 0x9f, 0xa5, 0xf0, 0x9f, 0x9f, 0xa7, 0xf0, 0x9f, 0x9f, 0xa8, 0xf0, 0x9f, 0x9f, 0xa9, 0xf0, 0x9f, 0x9f, 0xa6, 0xf0, 0x9f, 0x9f, 0xaa, 0xf0, 0x9f, 0x9f, 0xab, 0xe2, 0xac, 0x9b, 0xe2, 0xac, 0x9c, 0xe2, 0x97, 0xbc, 0xef, 0xb8, 0x8f, 0xe2, 0x97, 0xbb, 0xef, 0xb8, 0x8f, 0xe2, 0x97, 0xbe, 0xe2, 0x97, 0xbd, 0xe2, 0x96, 0xaa, 0xef, 0xb8, 0x8f, 0xe2, 0x96, 0xab, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x94, 0xb6, 0xf0, 0x9f, 0x94, 0xb7, 0xf0, 0x9f, 0x94, 0xb8, 0xf0, 0x9f, 0x94, 0xb9, 0xf0, 0x9f, 0x94, 0xba, 0xf0, 0x9f, 0x94, 0xbb, 0xf0, 0x9f, 0x92, 0xa0, 0xf0, 0x9f, 0x94, 0x98, 0xf0, 0x9f, 0x94, 0xb3, 0xf0, 0x9f, 0x94, 0xb2, 0xf0, 0x9f, 0x8f, 0x81, 0xf0, 0x9f, 0x9a, 0xa9, 0xf0, 0x9f, 0x8e, 0x8c, 0xf0, 0x9f, 0x8f, 0xb4, 0xf0, 0x9f, 0x8f, 0xb3, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0xb3, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xf0, 0x9f, 0x8c, 0x88, 0xf0, 0x9f, 0x8f, 0xb3, 0xef, 0xb8, 0x8f, 0xe2, 0x80, 0x8d, 0xe2, 0x9a, 0xa7, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x8f, 0xb4, 0xe2, 0x80, 0x8d, 0xe2, 0x98, 0xa0, 0xef, 0xb8, 0x8f, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xa9, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xab, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xb4, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xb6, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xb7, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xba, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xbc, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xbd, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xbf, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xa9, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xab, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xad, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xaf, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xb4, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xb6, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xb7, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xbb, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xbc, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xbe, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xbf, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xa9, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xab, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xad, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xb4, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xb5, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xb7, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xba, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xbb, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xbc, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xbd, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xbe, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xbf, 0xf0, 0x9f, 0x87, 0xa9, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xa9, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xa9, 0xf0, 0x9f, 0x87, 0xaf, 0xf0, 0x9f, 0x87, 0xa9, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xa9, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xa9, 0xf0, 0x9f, 0x87, 0xb4, 0xf0, 0x9f, 0x87, 0xa9, 0xf0, 0x9f, 0x87, 0xbf, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xad, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xb7, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xba, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xba, 0xf0, 0x9f, 0x87, 0xab, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xab, 0xf0, 0x9f, 0x87, 0xaf, 0xf0, 0x9f, 0x87, 0xab, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xab, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xab, 0xf0, 0x9f, 0x87, 0xb4, 0xf0, 0x9f, 0x87, 0xab, 0xf0, 0x9f, 0x87, 0xb7, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xa9, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xab, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xad, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xb5, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xb6, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xb7, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xba, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xbc, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xbe, 0xf0, 0x9f, 0x87, 0xad, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xad, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xad, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xad, 0xf0, 0x9f, 0x87, 0xb7, 0xf0, 0x9f, 0x87, 0xad, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xad, 0xf0, 0x9f, 0x87, 0xba, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xa9, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xb4, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xb6, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xb7, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xaf, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xaf, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xaf, 0xf0, 0x9f, 0x87, 0xb4, 0xf0, 0x9f, 0x87, 0xaf, 0xf0, 0x9f, 0x87, 0xb5, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xad, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xb5, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xb7, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xbc, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xbe, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xbf, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xb7, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xba, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xbb, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xbe, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xa9, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xab, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xad, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xb4, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xb5, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xb6, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xb7, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xba, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xbb, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xbc, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xbd, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xbe, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xbf, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xab, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xb4, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xb5, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xb7, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xba, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xbf, 0xf0, 0x9f, 0x87, 0xb4, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xb5, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xb5, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xb5, 0xf0, 0x9f, 0x87, 0xab, 0xf0, 0x9f, 0x87, 0xb5, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xb5, 0xf0, 0x9f, 0x87, 0xad, 0xf0, 0x9f, 0x87, 0xb5, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xb5, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xb5, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xb5, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xb5, 0xf0, 0x9f, 0x87, 0xb7, 0xf0, 0x9f, 0x87, 0xb5, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xb5, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xb5, 0xf0, 0x9f, 0x87, 0xbc, 0xf0, 0x9f, 0x87, 0xb5, 0xf0, 0x9f, 0x87, 0xbe, 0xf0, 0x9f, 0x87, 0xb6, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xb7, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xb7, 0xf0, 0x9f, 0x87, 0xb4, 0xf0, 0x9f, 0x87, 0xb7, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xb7, 0xf0, 0x9f, 0x87, 0xba, 0xf0, 0x9f, 0x87, 0xb7, 0xf0, 0x9f, 0x87, 0xbc, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xa7, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xa9, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xad, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xaf, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xb4, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xb7, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xbb, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xbd, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xbe, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xbf, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xa9, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xab, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xad, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xaf, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xb1, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xb4, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xb7, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xbb, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xbc, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xbf, 0xf0, 0x9f, 0x87, 0xba, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xba, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xba, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xba, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xba, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xba, 0xf0, 0x9f, 0x87, 0xbe, 0xf0, 0x9f, 0x87, 0xba, 0xf0, 0x9f, 0x87, 0xbf, 0xf0, 0x9f, 0x87, 0xbb, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xbb, 0xf0, 0x9f, 0x87, 0xa8, 0xf0, 0x9f, 0x87, 0xbb, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xbb, 0xf0, 0x9f, 0x87, 0xac, 0xf0, 0x9f, 0x87, 0xbb, 0xf0, 0x9f, 0x87, 0xae, 0xf0, 0x9f, 0x87, 0xbb, 0xf0, 0x9f, 0x87, 0xb3, 0xf0, 0x9f, 0x87, 0xbb, 0xf0, 0x9f, 0x87, 0xba, 0xf0, 0x9f, 0x87, 0xbc, 0xf0, 0x9f, 0x87, 0xab, 0xf0, 0x9f, 0x87, 0xbc, 0xf0, 0x9f, 0x87, 0xb8, 0xf0, 0x9f, 0x87, 0xbd, 0xf0, 0x9f, 0x87, 0xb0, 0xf0, 0x9f, 0x87, 0xbe, 0xf0, 0x9f, 0x87, 0xaa, 0xf0, 0x9f, 0x87, 0xbe, 0xf0, 0x9f, 0x87, 0xb9, 0xf0, 0x9f, 0x87, 0xbf, 0xf0, 0x9f, 0x87, 0xa6, 0xf0, 0x9f, 0x87, 0xbf, 0xf0, 0x9f, 0x87, 0xb2, 0xf0, 0x9f, 0x87, 0xbf, 0xf0, 0x9f, 0x87, 0xbc, 0xf0, 0x9f, 0x8f, 0xb4, 0xf3, 0xa0, 0x81, 0xa7, 0xf3, 0xa0, 0x81, 0xa2, 0xf3, 0xa0, 0x81, 0xa5, 0xf3, 0xa0, 0x81, 0xae, 0xf3, 0xa0, 0x81, 0xa7, 0xf3, 0xa0, 0x81, 0xbf, 0xf0, 0x9f, 0x8f, 0xb4, 0xf3, 0xa0, 0x81, 0xa7, 0xf3, 0xa0, 0x81, 0xa2, 0xf3, 0xa0, 0x81, 0xb3, 0xf3, 0xa0, 0x81, 0xa3, 0xf3, 0xa0, 0x81, 0xb4, 0xf3, 0xa0, 0x81, 0xbf, 0xf0, 0x9f, 0x8f, 0xb4, 0xf3, 0xa0, 0x81, 0xa7, 0xf3, 0xa0, 0x81, 0xa2, 0xf3, 0xa0, 0x81, 0xb7, 0xf3, 0xa0, 0x81, 0xac, 0xf3, 0xa0, 0x81, 0xb3, 0xf3, 0xa0, 0x81, 0xbf}
+var _emojiCharactersIndex  = "\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x06\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x0e\x04\x04\x04\x04\x0b\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x0b\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x06\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x06\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x06\x04\x0d\x0d\x06\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x07\x04\x11\x07\x07\x04\x04\x04\x04\x07\x03\x03\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x06\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x06\x04\x04\x04\x04\x04\x03\x03\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x06\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x07\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x0d\x0d\x0b\x0b\x0b\x0b\x04\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0d\x0d\x0d\x04\x04\x04\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x0d\x0d\x04\x0d\x0d\x04\x04\x0d\x0d\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x0d\x0d\x0d\x0b\x0b\x0b\x0b\x0b\x0b\x0d\x0d\x0d\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0d\x0d\x0d\x0b\x0b\x0b\x0b\x0b\x0b\x04\x04\x0d\x0d\x07\x10\x10\x04\x0d\x0d\x04\x04\x0d\x0d\x04\x04\x04\x04\x0d\x0d\x04\x04\x04\x0d\x0d\x04\x0d\x0d\x0d\x04\x04\x04\x04\x0b\x0b\x0b\x04\x04\x04\x0b\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x04\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x0b\x04\x04\x0d\x0d\x04\x04\x04\x07\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x04\x04\x06\x04\x07\x10\x10\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x06\x0f\x0f\x0f\x0f\x07\x10\x10\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x04\x0d\x0d\x04\x04\x12\x04\x04\x04\x04\x1b\x1b\x1b\x04\x14\x14\x14\x04\x12\x12\x19\x19\x19\x12\x12\x19\x19\x19\x12\x12\x19\x19\x19\x0b\x12\x0b\x12\x12\x0b\x12\x0b\x12\x12\x07\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x0b\x04\x04\x04\x04\x04\x04\x0a\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x07\x04\x04\x04\x04\x0d\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x07\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x0a\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x07\x07\x04\x04\x04\x04\x04\x04\x04\x04\x04\x07\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x06\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x07\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x03\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x07\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x07\x04\x04\x07\x06\x04\x04\x07\x07\x07\x07\x07\x07\x07\x07\x04\x04\x04\x04\x07\x07\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x03\x04\x04\x04\x06\x04\x03\x03\x04\x04\x07\x04\x04\x04\x04\x04\x06\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x07\x07\x04\x04\x04\x04\x04\x04\x04\x04\x04\x07\x07\x07\x03\x04\x04\x04\x04\x04\x04\x03\x04\x03\x03\x04\x04\x07\x06\x07\x04\x06\x07\x04\x04\x04\x04\x04\x04\x04\x04\x07\x04\x04\x07\x04\x03\x03\x03\x03\x06\x06\x07\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x07\x06\x04\x04\x04\x03\x04\x04\x04\x06\x03\x06\x07\x07\x07\x07\x07\x07\x07\x07\x07\x04\x04\x04\x06\x03\x06\x03\x06\x06\x03\x06\x04\x04\x04\x04\x04\x04\x04\x04\x03\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x07\x07\x04\x07\x04\x04\x04\x04\x04\x03\x03\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x03\x06\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x07\x04\x04\x04\x04\x04\x04\x04\x06\x06\x06\x06\x06\x04\x04\x04\x04\x07\x04\x04\x04\x04\x04\x04\x07\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x07\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x06\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x07\x07\x07\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x06\x06\x04\x04\x04\x04\x04\x04\x04\x07\x07\x06\x07\x07\x04\x04\x04\x04\x04\x04\x07\x07\x04\x04\x04\x04\x04\x04\x04\x04\x07\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x07\x04\x04\x07\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x06\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x07\x06\x06\x07\x07\x07\x07\x04\x04\x04\x04\x04\x07\x04\x04\x07\x07\x04\x04\x04\x04\x04\x04\x04\x04\x07\x04\x04\x06\x07\x07\x07\x04\x04\x04\x04\x04\x07\x04\x04\x06\x06\x07\x07\x06\x04\x04\x04\x07\x04\x04\x04\x04\x06\x07\x06\x04\x04\x06\x04\x04\x04\x04\x06\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x07\x07\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x06\x04\x06\x04\x04\x04\x04\x04\x04\x04\x04\x03\x04\x04\x04\x04\x04\x04\x04\x04\x04\x06\x04\x03\x04\x04\x04\x04\x04\x04\x04\x04\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x06\x04\x04\x04\x04\x04\x04\x04\x04\x06\x07\x06\x06\x06\x06\x06\x06\x06\x04\x04\x04\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x04\x04\x04\x06\x03\x06\x06\x06\x03\x06\x04\x03\x04\x03\x06\x06\x06\x06\x04\x04\x04\x04\x04\x04\x04\x06\x06\x06\x06\x03\x03\x03\x04\x06\x06\x06\x03\x03\x03\x03\x03\x06\x04\x04\x06\x06\x06\x04\x04\x04\x03\x03\x06\x06\x03\x03\x03\x03\x06\x06\x06\x06\x05\x05\x06\x07\x07\x07\x07\x07\x07\x07\x07\x07\x07\x07\x07\x04\x04\x04\x04\x04\x04\x07\x04\x07\x04\x04\x04\x06\x04\x06\x04\x04\x07\x04\x07\x04\x04\x04\x04\x07\x07\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x06\x06\x04\x04\x04\x04\x04\x04\x04\x04\x04\x03\x03\x04\x04\x04\x04\x04\x04\x04\x03\x03\x06\x06\x03\x03\x06\x06\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x04\x07\x0e\x10\x0d\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x1c\x1c\x1c"
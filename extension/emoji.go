@@ -0,0 +1,351 @@
+package extension
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pgavlin/goldmark"
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/extension/ast"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/renderer"
+	"github.com/pgavlin/goldmark/renderer/html"
+	"github.com/pgavlin/goldmark/renderer/markdown"
+	"github.com/pgavlin/goldmark/text"
+	"github.com/pgavlin/goldmark/util"
+)
+
+//go:generate go run ../_tools emoji -o ../_tools/emoji.json
+//go:generate go run ../_tools emb-structs -i ../_tools/emoji.json -o ./emoji.gen.go
+
+var _emojiOnce sync.Once
+var _emojiMap map[string][]byte
+
+func buildEmojis() {
+	_emojiOnce.Do(func() {
+		_emojiMap = make(map[string][]byte, _emojiLength)
+
+		cName := 0
+		cCharacters := 0
+		for i := 0; i < _emojiLength; i++ {
+			tName := cName + int(_emojiNameIndex[i])
+			tCharacters := cCharacters + int(_emojiCharactersIndex[i])
+			_emojiMap[_emojiName[cName:tName]] = _emojiCharacters[cCharacters:tCharacters]
+			cName = tName
+			cCharacters = tCharacters
+		}
+	})
+}
+
+// LookUpEmoji returns (the UTF-8 encoded Unicode sequence, true) if an emoji
+// with the given shortcode like 'smile' is found, otherwise (nil, false).
+// Shortcodes are those of GitHub's gemoji database.
+func LookUpEmoji(shortCode string) ([]byte, bool) {
+	buildEmojis()
+	v, ok := _emojiMap[shortCode]
+	return v, ok
+}
+
+func isEmojiShortCodeChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '_' || c == '+' || c == '-'
+}
+
+type emojiParser struct {
+}
+
+var defaultEmojiParser = &emojiParser{}
+
+// NewEmojiParser returns a new InlineParser that parses emoji shortcodes like
+// ':smile:' and ':+1:'. Unknown shortcodes are left as text.
+func NewEmojiParser() parser.InlineParser {
+	return defaultEmojiParser
+}
+
+func (s *emojiParser) Trigger() []byte {
+	return []byte{':'}
+}
+
+func (s *emojiParser) CanParseConcurrently() bool {
+	return true
+}
+
+func (s *emojiParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	line, _ := block.PeekLine()
+	i := 1
+	for ; i < len(line) && isEmojiShortCodeChar(line[i]); i++ {
+	}
+	if i == 1 || i == len(line) || line[i] != ':' {
+		return nil
+	}
+	characters, ok := LookUpEmoji(string(line[1:i]))
+	if !ok {
+		return nil
+	}
+	block.Advance(i + 1)
+	return ast.NewEmoji(append([]byte{}, line[1:i]...), characters)
+}
+
+// EmojiRenderingMethod indicates how emojis are rendered in HTML format.
+type EmojiRenderingMethod int
+
+const (
+	// EmojiUnicode renders emojis as Unicode characters.
+	EmojiUnicode EmojiRenderingMethod = iota
+
+	// EmojiImage renders emojis as img elements whose src is
+	// EmojiConfig.ImageURL.
+	EmojiImage
+
+	// EmojiSpan renders emojis as Unicode characters in span elements whose
+	// class is EmojiConfig.SpanClass.
+	EmojiSpan
+)
+
+// EmojiConfig struct holds options for the extension.
+type EmojiConfig struct {
+	html.Config
+
+	// RenderingMethod indicates how emojis are rendered.
+	RenderingMethod EmojiRenderingMethod
+
+	// ImageURL is a template of URLs of emoji images. '{shortcode}' is
+	// replaced with the shortcode of an emoji, and '{codepoints}' is
+	// replaced with the code points of an emoji in lower case hexadecimal,
+	// separated by '-'.
+	ImageURL string
+
+	// SpanClass is a class of span elements.
+	SpanClass string
+}
+
+// EmojiOption interface is a functional option interface for the extension.
+type EmojiOption interface {
+	renderer.Option
+	// SetEmojiOption sets given option to the extension.
+	SetEmojiOption(*EmojiConfig)
+}
+
+// NewEmojiConfig returns a new Config with defaults.
+func NewEmojiConfig() EmojiConfig {
+	return EmojiConfig{
+		Config:          html.NewConfig(),
+		RenderingMethod: EmojiUnicode,
+		ImageURL:        "https://github.githubassets.com/images/icons/emoji/unicode/{codepoints}.png",
+		SpanClass:       "emoji",
+	}
+}
+
+const (
+	optEmojiRenderingMethod renderer.OptionName = "EmojiRenderingMethod"
+	optEmojiImageURL        renderer.OptionName = "EmojiImageURL"
+	optEmojiSpanClass       renderer.OptionName = "EmojiSpanClass"
+)
+
+// SetOption implements renderer.SetOptioner.
+func (c *EmojiConfig) SetOption(name renderer.OptionName, value interface{}) {
+	switch name {
+	case optEmojiRenderingMethod:
+		c.RenderingMethod = value.(EmojiRenderingMethod)
+	case optEmojiImageURL:
+		c.ImageURL = value.(string)
+	case optEmojiSpanClass:
+		c.SpanClass = value.(string)
+	default:
+		c.Config.SetOption(name, value)
+	}
+}
+
+type withEmojiHTMLOptions struct {
+	value []html.Option
+}
+
+func (o *withEmojiHTMLOptions) SetConfig(c *renderer.Config) {
+	for _, v := range o.value {
+		v.(renderer.Option).SetConfig(c)
+	}
+}
+
+func (o *withEmojiHTMLOptions) SetEmojiOption(c *EmojiConfig) {
+	for _, v := range o.value {
+		v.SetHTMLOption(&c.Config)
+	}
+}
+
+// WithEmojiHTMLOptions is functional option that wraps goldmark HTMLRenderer options.
+func WithEmojiHTMLOptions(opts ...html.Option) EmojiOption {
+	return &withEmojiHTMLOptions{opts}
+}
+
+type withEmojiRenderingMethod struct {
+	value EmojiRenderingMethod
+}
+
+func (o *withEmojiRenderingMethod) SetConfig(c *renderer.Config) {
+	c.Options[optEmojiRenderingMethod] = o.value
+}
+
+func (o *withEmojiRenderingMethod) SetEmojiOption(c *EmojiConfig) {
+	c.RenderingMethod = o.value
+}
+
+// WithEmojiRenderingMethod is a functional option that indicates how emojis
+// are rendered in HTML format.
+func WithEmojiRenderingMethod(a EmojiRenderingMethod) EmojiOption {
+	return &withEmojiRenderingMethod{a}
+}
+
+type withEmojiImageURL struct {
+	value string
+}
+
+func (o *withEmojiImageURL) SetConfig(c *renderer.Config) {
+	c.Options[optEmojiImageURL] = o.value
+}
+
+func (o *withEmojiImageURL) SetEmojiOption(c *EmojiConfig) {
+	c.ImageURL = o.value
+}
+
+// WithEmojiImageURL is a functional option that sets a template of URLs of
+// emoji images. See EmojiConfig.ImageURL for details.
+func WithEmojiImageURL(template string) EmojiOption {
+	return &withEmojiImageURL{template}
+}
+
+type withEmojiSpanClass struct {
+	value string
+}
+
+func (o *withEmojiSpanClass) SetConfig(c *renderer.Config) {
+	c.Options[optEmojiSpanClass] = o.value
+}
+
+func (o *withEmojiSpanClass) SetEmojiOption(c *EmojiConfig) {
+	c.SpanClass = o.value
+}
+
+// WithEmojiSpanClass is a functional option that sets a class of span
+// elements of emojis.
+func WithEmojiSpanClass(class string) EmojiOption {
+	return &withEmojiSpanClass{class}
+}
+
+// EmojiHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders Emoji nodes.
+type EmojiHTMLRenderer struct {
+	EmojiConfig
+}
+
+// NewEmojiHTMLRenderer returns a new EmojiHTMLRenderer.
+func NewEmojiHTMLRenderer(opts ...EmojiOption) renderer.NodeRenderer {
+	r := &EmojiHTMLRenderer{
+		EmojiConfig: NewEmojiConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetEmojiOption(&r.EmojiConfig)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *EmojiHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindEmoji, r.renderEmoji)
+}
+
+func (r *EmojiHTMLRenderer) renderEmoji(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	n := node.(*ast.Emoji)
+	switch r.RenderingMethod {
+	case EmojiImage:
+		var codePoints []string
+		for _, c := range string(n.Characters) {
+			codePoints = append(codePoints, fmt.Sprintf("%x", c))
+		}
+		url := strings.NewReplacer(
+			"{shortcode}", string(n.ShortCode),
+			"{codepoints}", strings.Join(codePoints, "-"),
+		).Replace(r.ImageURL)
+		_, _ = w.WriteString(`<img class="emoji" src="`)
+		_, _ = w.Write(util.EscapeHTML(util.URLEscape([]byte(url), false)))
+		_, _ = w.WriteString(`" alt=":`)
+		_, _ = w.Write(n.ShortCode)
+		_, _ = w.WriteString(`:" title=":`)
+		_, _ = w.Write(n.ShortCode)
+		if r.XHTML {
+			_, _ = w.WriteString(`:" />`)
+		} else {
+			_, _ = w.WriteString(`:">`)
+		}
+	case EmojiSpan:
+		_, _ = w.WriteString(`<span class="`)
+		_, _ = w.Write(util.EscapeHTML([]byte(r.SpanClass)))
+		_, _ = w.WriteString(`" role="img" aria-label=":`)
+		_, _ = w.Write(n.ShortCode)
+		_, _ = w.WriteString(`:">`)
+		_, _ = w.Write(n.Characters)
+		_, _ = w.WriteString(`</span>`)
+	default:
+		_, _ = w.Write(n.Characters)
+	}
+	return gast.WalkContinue, nil
+}
+
+// EmojiMarkdownRenderer is a renderer.NodeRenderer implementation that
+// writes Emoji nodes back to Markdown as shortcodes using a
+// markdown.Renderer.
+type EmojiMarkdownRenderer struct {
+	*markdown.Renderer
+}
+
+// NewEmojiMarkdownRenderer returns a new EmojiMarkdownRenderer that writes
+// through the given markdown.Renderer.
+func NewEmojiMarkdownRenderer(r *markdown.Renderer) renderer.NodeRenderer {
+	return &EmojiMarkdownRenderer{r}
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *EmojiMarkdownRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindEmoji, r.renderEmoji)
+}
+
+func (r *EmojiMarkdownRenderer) renderEmoji(
+	w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	shortCode := append(append([]byte{':'}, n.(*ast.Emoji).ShortCode...), ':')
+	if _, err := r.Write(w, shortCode); err != nil {
+		return gast.WalkStop, err
+	}
+	return gast.WalkContinue, nil
+}
+
+type emoji struct {
+	options []EmojiOption
+}
+
+// Emoji is an extension that replaces emoji shortcodes like ':smile:' with
+// emojis.
+var Emoji = &emoji{
+	options: []EmojiOption{},
+}
+
+// NewEmoji returns a new extension with given options.
+func NewEmoji(opts ...EmojiOption) goldmark.Extender {
+	return &emoji{
+		options: opts,
+	}
+}
+
+func (e *emoji) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(NewEmojiParser(), 999),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewEmojiHTMLRenderer(e.options...), 500),
+	))
+}
@@ -0,0 +1,53 @@
+package extension
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pgavlin/goldmark"
+	"github.com/pgavlin/goldmark/renderer/html"
+	"github.com/pgavlin/goldmark/testutil"
+)
+
+func TestEmoji(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			Emoji,
+		),
+	)
+	testutil.DoTestCaseFile(markdown, "_test/emoji.txt", t, testutil.ParseCliCaseArg()...)
+}
+
+func TestEmojiRenderingMethods(t *testing.T) {
+	cases := []struct {
+		options  []EmojiOption
+		expected string
+	}{
+		{
+			[]EmojiOption{WithEmojiRenderingMethod(EmojiImage)},
+			`<p><img class="emoji" src="https://github.githubassets.com/images/icons/emoji/unicode/1f44d.png" alt=":+1:" title=":+1:"></p>` + "\n",
+		},
+		{
+			[]EmojiOption{
+				WithEmojiRenderingMethod(EmojiImage),
+				WithEmojiImageURL("/emoji/{shortcode}.png"),
+				WithEmojiHTMLOptions(html.WithXHTML()),
+			},
+			`<p><img class="emoji" src="/emoji/+1.png" alt=":+1:" title=":+1:" /></p>` + "\n",
+		},
+		{
+			[]EmojiOption{WithEmojiRenderingMethod(EmojiSpan), WithEmojiSpanClass("e")},
+			`<p><span class="e" role="img" aria-label=":+1:">👍</span></p>` + "\n",
+		},
+	}
+	for _, c := range cases {
+		md := goldmark.New(goldmark.WithExtensions(NewEmoji(c.options...)))
+		var buf bytes.Buffer
+		if err := md.Convert([]byte(":+1:"), &buf); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != c.expected {
+			t.Errorf("expected %q, got %q", c.expected, buf.String())
+		}
+	}
+}
@@ -3,7 +3,10 @@ package extension
 import (
 	"bytes"
 	"fmt"
+	"sort"
 	"strconv"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/pgavlin/goldmark"
 	gast "github.com/pgavlin/goldmark/ast"
@@ -15,8 +18,11 @@ import (
 	"github.com/pgavlin/goldmark/util"
 )
 
-var footnoteListKey = parser.NewContextKey()
-var footnoteLinkListKey = parser.NewContextKey()
+// Footnotes in nested inlines like labels of inline directives are
+// footnotes of the enclosing document.
+var footnoteListKey = shareWithNestedInlines(parser.NewContextKey())
+var footnoteLinkListKey = shareWithNestedInlines(parser.NewContextKey())
+var inlineFootnoteListKey = shareWithNestedInlines(parser.NewContextKey())
 
 type footnoteBlockParser struct {
 }
@@ -151,7 +157,7 @@ func (s *footnoteParser) Parse(parent gast.Node, block text.Reader, pc parser.Co
 	if list == nil {
 		return nil
 	}
-	index := 0
+	var fn *ast.Footnote
 	for def := list.FirstChild(); def != nil; def = def.NextSibling() {
 		d := def.(*ast.Footnote)
 		if bytes.Equal(d.Ref, value) {
@@ -159,30 +165,110 @@ func (s *footnoteParser) Parse(parent gast.Node, block text.Reader, pc parser.Co
 				list.Count++
 				d.Index = list.Count
 			}
-			index = d.Index
+			fn = d
 			break
 		}
 	}
-	if index == 0 {
+	if fn == nil {
 		return nil
 	}
 
-	fnlink := ast.NewFootnoteLink(index)
+	fnlink := ast.NewFootnoteLink(fn.Index)
+	fnlink.Ref = fn.Ref
+	addFootnoteLink(pc, fnlink)
+	if line[0] == '!' {
+		parent.AppendChild(parent, gast.NewTextSegment(text.NewSegment(segment.Start, segment.Start+1)))
+	}
+
+	return fnlink
+}
+
+func addFootnoteLink(pc parser.Context, fnlink *ast.FootnoteLink) {
 	var fnlist []*ast.FootnoteLink
 	if tmp := pc.Get(footnoteLinkListKey); tmp != nil {
 		fnlist = tmp.([]*ast.FootnoteLink)
-	} else {
-		fnlist = []*ast.FootnoteLink{}
-		pc.Set(footnoteLinkListKey, fnlist)
 	}
 	pc.Set(footnoteLinkListKey, append(fnlist, fnlink))
-	if line[0] == '!' {
-		parent.AppendChild(parent, gast.NewTextSegment(text.NewSegment(segment.Start, segment.Start+1)))
+}
+
+type inlineFootnoteParser struct {
+	parser parser.Parser
+}
+
+// NewInlineFootnoteParser returns a new parser.InlineParser that can parse
+// Pandoc style inline footnotes like '^[the note itself]'. An inline
+// footnote creates a Footnote and a link to it. The note is parsed with the
+// given parser.
+func NewInlineFootnoteParser(p parser.Parser) parser.InlineParser {
+	return &inlineFootnoteParser{
+		parser: p,
+	}
+}
+
+var inlineFootnoteFindClosureOptions = text.FindClosureOptions{
+	CodeSpan: true,
+	Nesting:  true,
+	Newline:  true,
+	Advance:  true,
+}
+
+func (s *inlineFootnoteParser) Trigger() []byte {
+	return []byte{'^'}
+}
+
+func (s *inlineFootnoteParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	line, _ := block.PeekLine()
+	if len(line) < 2 || line[1] != '[' {
+		return nil
+	}
+	savedLine, savedPosition := block.Position()
+	block.Advance(2)
+	segments, found := block.FindClosure('[', ']', inlineFootnoteFindClosureOptions)
+	if !found || isBlankSegments(block.Source(), segments) {
+		block.SetPosition(savedLine, savedPosition)
+		return nil
+	}
+
+	var list *ast.FootnoteList
+	if tlist := pc.Get(footnoteListKey); tlist != nil {
+		list = tlist.(*ast.FootnoteList)
+	} else {
+		// The list is added to the end of the document by the
+		// footnoteASTTransformer.
+		list = ast.NewFootnoteList()
+		pc.Set(footnoteListKey, list)
 	}
+	list.Count++
+	fn := ast.NewFootnote(nil)
+	fn.Index = list.Count
+	// Inline footnotes are added to the list by the footnoteASTTransformer,
+	// because blocks in the list may not have been parsed yet.
+	var inlineList []*ast.Footnote
+	if tmp := pc.Get(inlineFootnoteListKey); tmp != nil {
+		inlineList = tmp.([]*ast.Footnote)
+	}
+	pc.Set(inlineFootnoteListKey, append(inlineList, fn))
+
+	fnlink := ast.NewFootnoteLink(fn.Index)
+	addFootnoteLink(pc, fnlink)
 
+	paragraph := gast.NewParagraph()
+	paragraph.SetLines(segments)
+	fn.AppendChild(fn, paragraph)
+	parseNestedInlines(s.parser, paragraph, segments, block.Source(), pc)
 	return fnlink
 }
 
+func isBlankSegments(source []byte, segments *text.Segments) bool {
+	for i := 0; i < segments.Len(); i++ {
+		segment := segments.At(i)
+		if !util.IsBlank(segment.Value(source)) {
+			return false
+		}
+	}
+	return true
+}
+
 type footnoteASTTransformer struct {
 }
 
@@ -204,12 +290,22 @@ func (a *footnoteASTTransformer) Transform(node *gast.Document, reader text.Read
 		fnlist = tmp.([]*ast.FootnoteLink)
 	}
 
+	var inlineList []*ast.Footnote
+	if tmp := pc.Get(inlineFootnoteListKey); tmp != nil {
+		inlineList = tmp.([]*ast.Footnote)
+	}
+
 	pc.Set(footnoteListKey, nil)
 	pc.Set(footnoteLinkListKey, nil)
+	pc.Set(inlineFootnoteListKey, nil)
 
 	if list == nil {
 		return
 	}
+	for _, fn := range inlineList {
+		list.AppendChild(list, fn)
+	}
+	ids := assignFootnoteIDs(list)
 
 	counter := map[int]int{}
 	if fnlist != nil {
@@ -225,6 +321,7 @@ func (a *footnoteASTTransformer) Transform(node *gast.Document, reader text.Read
 				refCounter[fnlink.Index] = 0
 			}
 			fnlink.RefIndex = refCounter[fnlink.Index]
+			fnlink.ID = ids[fnlink.Index]
 			refCounter[fnlink.Index]++
 		}
 	}
@@ -243,12 +340,16 @@ func (a *footnoteASTTransformer) Transform(node *gast.Document, reader text.Read
 			backLink := ast.NewFootnoteBacklink(index)
 			backLink.RefCount = refCount
 			backLink.RefIndex = 0
+			backLink.Ref = fn.Ref
+			backLink.ID = fn.ID
 			container.AppendChild(container, backLink)
 			if refCount > 1 {
 				for i := 1; i < refCount; i++ {
 					backLink := ast.NewFootnoteBacklink(index)
 					backLink.RefCount = refCount
 					backLink.RefIndex = i
+					backLink.Ref = fn.Ref
+					backLink.ID = fn.ID
 					container.AppendChild(container, backLink)
 				}
 			}
@@ -269,6 +370,50 @@ func (a *footnoteASTTransformer) Transform(node *gast.Document, reader text.Read
 	node.AppendChild(node, list)
 }
 
+// assignFootnoteIDs sets the IDs of the referenced footnotes in the given
+// list and returns them by the numbers of the footnotes.
+//
+// IDs are the sanitized labels of footnotes. Inline footnotes and footnotes
+// whose labels have no characters left get IDs like 'inline:2' and '2'. If
+// an ID is already used by a footnote with a lower number, a suffix like ':2'
+// is added. Sanitized labels never contain ':', so IDs of inline footnotes
+// never collide with labels.
+func assignFootnoteIDs(list *ast.FootnoteList) map[int][]byte {
+	var footnotes []*ast.Footnote
+	for c := list.FirstChild(); c != nil; c = c.NextSibling() {
+		if fn := c.(*ast.Footnote); fn.Index >= 0 {
+			footnotes = append(footnotes, fn)
+		}
+	}
+	sort.Slice(footnotes, func(i, j int) bool {
+		return footnotes[i].Index < footnotes[j].Index
+	})
+
+	used := map[string]bool{}
+	ids := map[int][]byte{}
+	for _, fn := range footnotes {
+		id := "inline:" + strconv.Itoa(fn.Index)
+		if fn.Ref != nil {
+			id = string(sanitizeFootnoteLabel(fn.Ref))
+			if id == "" {
+				id = strconv.Itoa(fn.Index)
+			}
+		}
+		if used[id] {
+			for i := 2; ; i++ {
+				if candidate := id + ":" + strconv.Itoa(i); !used[candidate] {
+					id = candidate
+					break
+				}
+			}
+		}
+		used[id] = true
+		fn.ID = []byte(id)
+		ids[fn.Index] = fn.ID
+	}
+	return ids
+}
+
 // FootnoteConfig holds configuration values for the footnote extension.
 //
 // Link* and Backlink* configurations have some variables:
@@ -299,6 +444,17 @@ type FootnoteConfig struct {
 
 	// BacklinkHTML is an HTML content for footnote backlinks.
 	BacklinkHTML []byte
+
+	// LabelIDs is true if id attributes are generated from the labels of
+	// footnotes, like 'fn:my-label', instead of their numbers. Inline
+	// footnotes have no labels, so their id attributes are like
+	// 'fn:inline:2'. Labels that result in the same id, like 'my label'
+	// and 'my-label', get ids like 'fn:my-label' and 'fn:my-label:2'.
+	LabelIDs bool
+
+	// InlineFootnotes is true if Pandoc style inline footnotes like
+	// '^[the note itself]' are parsed.
+	InlineFootnotes bool
 }
 
 // FootnoteOption interface is a functional option interface for the extension.
@@ -337,6 +493,10 @@ func (c *FootnoteConfig) SetOption(name renderer.OptionName, value interface{})
 		c.BacklinkClass = value.([]byte)
 	case optFootnoteBacklinkHTML:
 		c.BacklinkHTML = value.([]byte)
+	case optFootnoteLabelIDs:
+		c.LabelIDs = value.(bool)
+	case optInlineFootnotes:
+		c.InlineFootnotes = value.(bool)
 	default:
 		c.Config.SetOption(name, value)
 	}
@@ -500,6 +660,45 @@ func WithFootnoteBacklinkHTML[T []byte | string](a T) FootnoteOption {
 	return &withFootnoteBacklinkHTML{[]byte(a)}
 }
 
+const optFootnoteLabelIDs renderer.OptionName = "FootnoteLabelIDs"
+
+type withFootnoteLabelIDs struct {
+}
+
+func (o *withFootnoteLabelIDs) SetConfig(c *renderer.Config) {
+	c.Options[optFootnoteLabelIDs] = true
+}
+
+func (o *withFootnoteLabelIDs) SetFootnoteOption(c *FootnoteConfig) {
+	c.LabelIDs = true
+}
+
+// WithFootnoteLabelIDs is a functional option that generates id attributes
+// from the labels of footnotes instead of their numbers, so that anchors
+// stay stable when footnotes are reordered.
+func WithFootnoteLabelIDs() FootnoteOption {
+	return &withFootnoteLabelIDs{}
+}
+
+const optInlineFootnotes renderer.OptionName = "InlineFootnotes"
+
+type withInlineFootnotes struct {
+}
+
+func (o *withInlineFootnotes) SetConfig(c *renderer.Config) {
+	c.Options[optInlineFootnotes] = true
+}
+
+func (o *withInlineFootnotes) SetFootnoteOption(c *FootnoteConfig) {
+	c.InlineFootnotes = true
+}
+
+// WithInlineFootnotes is a functional option that enables Pandoc style
+// inline footnotes like '^[the note itself]'.
+func WithInlineFootnotes() FootnoteOption {
+	return &withInlineFootnotes{}
+}
+
 // FootnoteHTMLRenderer is a renderer.NodeRenderer implementation that
 // renders FootnoteLink nodes.
 type FootnoteHTMLRenderer struct {
@@ -530,6 +729,7 @@ func (r *FootnoteHTMLRenderer) renderFootnoteLink(
 	if entering {
 		n := node.(*ast.FootnoteLink)
 		is := strconv.Itoa(n.Index)
+		id := r.footnoteID(n.Index, n.ID)
 		_, _ = w.WriteString(`<sup id="`)
 		_, _ = w.Write(r.idPrefix(node))
 		_, _ = w.WriteString(`fnref`)
@@ -537,11 +737,11 @@ func (r *FootnoteHTMLRenderer) renderFootnoteLink(
 			_, _ = w.WriteString(fmt.Sprintf("%v", n.RefIndex))
 		}
 		_ = w.WriteByte(':')
-		_, _ = w.WriteString(is)
+		_, _ = w.Write(id)
 		_, _ = w.WriteString(`"><a href="#`)
 		_, _ = w.Write(r.idPrefix(node))
 		_, _ = w.WriteString(`fn:`)
-		_, _ = w.WriteString(is)
+		_, _ = w.Write(id)
 		_, _ = w.WriteString(`" class="`)
 		_, _ = w.Write(applyFootnoteTemplate(r.FootnoteConfig.LinkClass,
 			n.Index, n.RefCount))
@@ -561,7 +761,6 @@ func (r *FootnoteHTMLRenderer) renderFootnoteBacklink(
 	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
 	if entering {
 		n := node.(*ast.FootnoteBacklink)
-		is := strconv.Itoa(n.Index)
 		_, _ = w.WriteString(`&#160;<a href="#`)
 		_, _ = w.Write(r.idPrefix(node))
 		_, _ = w.WriteString(`fnref`)
@@ -569,7 +768,7 @@ func (r *FootnoteHTMLRenderer) renderFootnoteBacklink(
 			_, _ = w.WriteString(fmt.Sprintf("%v", n.RefIndex))
 		}
 		_ = w.WriteByte(':')
-		_, _ = w.WriteString(is)
+		_, _ = w.Write(r.footnoteID(n.Index, n.ID))
 		_, _ = w.WriteString(`" class="`)
 		_, _ = w.Write(applyFootnoteTemplate(r.FootnoteConfig.BacklinkClass, n.Index, n.RefCount))
 		if len(r.FootnoteConfig.BacklinkTitle) > 0 {
@@ -586,12 +785,11 @@ func (r *FootnoteHTMLRenderer) renderFootnoteBacklink(
 func (r *FootnoteHTMLRenderer) renderFootnote(
 	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
 	n := node.(*ast.Footnote)
-	is := strconv.Itoa(n.Index)
 	if entering {
 		_, _ = w.WriteString(`<li id="`)
 		_, _ = w.Write(r.idPrefix(node))
 		_, _ = w.WriteString(`fn:`)
-		_, _ = w.WriteString(is)
+		_, _ = w.Write(r.footnoteID(n.Index, n.ID))
 		_, _ = w.WriteString(`"`)
 		if node.Attributes() != nil {
 			html.RenderAttributes(w, node, html.ListItemAttributeFilter)
@@ -634,6 +832,31 @@ func (r *FootnoteHTMLRenderer) idPrefix(node gast.Node) []byte {
 	return []byte("")
 }
 
+// footnoteID returns the part of the id attributes that identifies the
+// footnote with the given number and ID.
+func (r *FootnoteHTMLRenderer) footnoteID(index int, id []byte) []byte {
+	if r.FootnoteConfig.LabelIDs && len(id) > 0 {
+		return id
+	}
+	return []byte(strconv.Itoa(index))
+}
+
+// sanitizeFootnoteLabel returns the given label with each run of characters
+// other than letters, digits, '-', '_' and '.' replaced by a '-'.
+func sanitizeFootnoteLabel(label []byte) []byte {
+	id := make([]byte, 0, len(label))
+	for len(label) > 0 {
+		r, size := utf8.DecodeRune(label)
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_' || r == '.' {
+			id = append(id, label[:size]...)
+		} else if len(id) > 0 && id[len(id)-1] != '-' {
+			id = append(id, '-')
+		}
+		label = label[size:]
+	}
+	return bytes.Trim(id, "-")
+}
+
 func applyFootnoteTemplate(b []byte, index, refCount int) []byte {
 	fast := true
 	for i, c := range b {
@@ -662,6 +885,7 @@ type footnote struct {
 }
 
 // Footnote is an extension that allow you to use PHP Markdown Extra Footnotes.
+// Pandoc style inline footnotes are enabled with WithInlineFootnotes.
 var Footnote = &footnote{
 	options: []FootnoteOption{},
 }
@@ -674,13 +898,21 @@ func NewFootnote(opts ...FootnoteOption) goldmark.Extender {
 }
 
 func (e *footnote) Extend(m goldmark.Markdown) {
+	config := NewFootnoteConfig()
+	for _, opt := range e.options {
+		opt.SetFootnoteOption(&config)
+	}
+	inlineParsers := []util.PrioritizedValue{
+		util.Prioritized(NewFootnoteParser(), 101),
+	}
+	if config.InlineFootnotes {
+		inlineParsers = append(inlineParsers, util.Prioritized(NewInlineFootnoteParser(m.Parser()), 101))
+	}
 	m.Parser().AddOptions(
 		parser.WithBlockParsers(
 			util.Prioritized(NewFootnoteBlockParser(), 999),
 		),
-		parser.WithInlineParsers(
-			util.Prioritized(NewFootnoteParser(), 101),
-		),
+		parser.WithInlineParsers(inlineParsers...),
 		parser.WithASTTransformers(
 			util.Prioritized(NewFootnoteASTTransformer(), 999),
 		),
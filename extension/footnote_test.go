@@ -1,6 +1,7 @@
 package extension
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/pgavlin/goldmark"
@@ -18,12 +19,25 @@ func TestFootnote(t *testing.T) {
 			html.WithUnsafe(),
 		),
 		goldmark.WithExtensions(
-			Footnote,
+			NewFootnote(
+				WithInlineFootnotes(),
+			),
 		),
 	)
 	testutil.DoTestCaseFile(markdown, "_test/footnote.txt", t, testutil.ParseCliCaseArg()...)
 }
 
+func TestFootnoteWithoutInlineFootnotes(t *testing.T) {
+	md := goldmark.New(goldmark.WithExtensions(Footnote))
+	var buf bytes.Buffer
+	if err := md.Convert([]byte("Text^[not a note]\n"), &buf); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "<p>Text^[not a note]</p>\n"; buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
 type footnoteID struct {
 }
 
@@ -139,3 +153,147 @@ Another one.[^2]
 		t,
 	)
 }
+
+func TestFootnoteLabelIDs(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewFootnote(
+				WithFootnoteLabelIDs(),
+				WithInlineFootnotes(),
+			),
+		),
+	)
+
+	testutil.DoTestCase(
+		markdown,
+		testutil.MarkdownTestCase{
+			No:          1,
+			Description: "Footnote ids from labels",
+			Markdown: `Some text.[^My Note!] Inline.^[An inline note.] Again.[^My Note!]
+
+[^My Note!]: A labeled note.
+`,
+			Expected: `<p>Some text.<sup id="fnref:My-Note"><a href="#fn:My-Note" class="footnote-ref" role="doc-noteref">1</a></sup> Inline.<sup id="fnref:inline:2"><a href="#fn:inline:2" class="footnote-ref" role="doc-noteref">2</a></sup> Again.<sup id="fnref1:My-Note"><a href="#fn:My-Note" class="footnote-ref" role="doc-noteref">1</a></sup></p>
+<div class="footnotes" role="doc-endnotes">
+<hr>
+<ol>
+<li id="fn:My-Note">
+<p>A labeled note.&#160;<a href="#fnref:My-Note" class="footnote-backref" role="doc-backlink">&#x21a9;&#xfe0e;</a>&#160;<a href="#fnref1:My-Note" class="footnote-backref" role="doc-backlink">&#x21a9;&#xfe0e;</a></p>
+</li>
+<li id="fn:inline:2">
+<p>An inline note.&#160;<a href="#fnref:inline:2" class="footnote-backref" role="doc-backlink">&#x21a9;&#xfe0e;</a></p>
+</li>
+</ol>
+</div>`,
+		},
+		t,
+	)
+}
+
+func TestFootnoteLabelIDCollisions(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewFootnote(
+				WithFootnoteLabelIDs(),
+				WithInlineFootnotes(),
+			),
+		),
+	)
+
+	testutil.DoTestCase(
+		markdown,
+		testutil.MarkdownTestCase{
+			No:          1,
+			Description: "Labels with the same id",
+			Markdown: `A.[^my label] B.[^my-label]
+
+[^my label]: One.
+[^my-label]: Two.
+`,
+			Expected: `<p>A.<sup id="fnref:my-label"><a href="#fn:my-label" class="footnote-ref" role="doc-noteref">1</a></sup> B.<sup id="fnref:my-label:2"><a href="#fn:my-label:2" class="footnote-ref" role="doc-noteref">2</a></sup></p>
+<div class="footnotes" role="doc-endnotes">
+<hr>
+<ol>
+<li id="fn:my-label">
+<p>One.&#160;<a href="#fnref:my-label" class="footnote-backref" role="doc-backlink">&#x21a9;&#xfe0e;</a></p>
+</li>
+<li id="fn:my-label:2">
+<p>Two.&#160;<a href="#fnref:my-label:2" class="footnote-backref" role="doc-backlink">&#x21a9;&#xfe0e;</a></p>
+</li>
+</ol>
+</div>`,
+		},
+		t,
+	)
+
+	testutil.DoTestCase(
+		markdown,
+		testutil.MarkdownTestCase{
+			No:          2,
+			Description: "Numeric labels and inline footnotes",
+			Markdown: `A.^[Inline.] B.[^1]
+
+[^1]: Labeled.
+`,
+			Expected: `<p>A.<sup id="fnref:inline:1"><a href="#fn:inline:1" class="footnote-ref" role="doc-noteref">1</a></sup> B.<sup id="fnref:1"><a href="#fn:1" class="footnote-ref" role="doc-noteref">2</a></sup></p>
+<div class="footnotes" role="doc-endnotes">
+<hr>
+<ol>
+<li id="fn:inline:1">
+<p>Inline.&#160;<a href="#fnref:inline:1" class="footnote-backref" role="doc-backlink">&#x21a9;&#xfe0e;</a></p>
+</li>
+<li id="fn:1">
+<p>Labeled.&#160;<a href="#fnref:1" class="footnote-backref" role="doc-backlink">&#x21a9;&#xfe0e;</a></p>
+</li>
+</ol>
+</div>`,
+		},
+		t,
+	)
+}
+
+func TestFootnoteLazyInlines(t *testing.T) {
+	md := goldmark.New(goldmark.WithExtensions(Footnote))
+	source := []byte("Hello[^1]\n\n[^1]: note\n")
+	pc := parser.NewContext()
+	doc := md.Parser().Parse(text.NewReader(source), parser.WithLazyInlines(), parser.WithContext(pc))
+	// The footnote transformer needs the footnote links found by inline
+	// parsers, so inlines are parsed eagerly.
+	if diagnostics := parser.Diagnostics(pc); len(diagnostics) != 1 ||
+		diagnostics[0].Severity != parser.DiagnosticWarning {
+		t.Errorf("expected a warning, got %v", diagnostics)
+	}
+	var buf bytes.Buffer
+	if err := md.Renderer().Render(&buf, source, doc); err != nil {
+		t.Fatal(err)
+	}
+	expected := `<p>Hello<sup id="fnref:1"><a href="#fn:1" class="footnote-ref" role="doc-noteref">1</a></sup></p>
+<div class="footnotes" role="doc-endnotes">
+<hr>
+<ol>
+<li id="fn:1">
+<p>note&#160;<a href="#fnref:1" class="footnote-backref" role="doc-backlink">&#x21a9;&#xfe0e;</a></p>
+</li>
+</ol>
+</div>
+`
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestInlineFootnoteSessionReferences(t *testing.T) {
+	md := goldmark.New(goldmark.WithExtensions(NewFootnote(WithInlineFootnotes())))
+	session := parser.NewSession()
+	md.Parser().Parse(text.NewReader([]byte("[x]: /x\n")), parser.WithSession(session, "a"))
+
+	source := []byte("Note^[see [x]]\n")
+	doc := md.Parser().Parse(text.NewReader(source), parser.WithSession(session, "b"))
+	var buf bytes.Buffer
+	if err := md.Renderer().Render(&buf, source, doc); err != nil {
+		t.Fatal(err)
+	}
+	if expected := `<p>see <a href="/x">x</a>&#160;`; !bytes.Contains(buf.Bytes(), []byte(expected)) {
+		t.Errorf("expected %q in %q", expected, buf.String())
+	}
+}
@@ -0,0 +1,497 @@
+package extension
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/pgavlin/goldmark"
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/extension/ast"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/renderer"
+	"github.com/pgavlin/goldmark/renderer/markdown"
+	"github.com/pgavlin/goldmark/text"
+	"github.com/pgavlin/goldmark/util"
+)
+
+// A FrontMatterFormat struct describes a syntax of front matter.
+type FrontMatterFormat struct {
+	// Name is a name of the format, like "yaml".
+	Name string
+
+	// Delimiter is a line that opens and closes the front matter.
+	Delimiter string
+
+	// Closers is a list of additional lines that close the front matter.
+	Closers []string
+
+	// Unmarshal decodes the contents of the front matter into v.
+	// v is a pointer to a map[string]interface{}. If Unmarshal is nil,
+	// front matter is recognized but not decoded.
+	Unmarshal func(data []byte, v interface{}) error
+}
+
+func (f *FrontMatterFormat) isCloser(line []byte) bool {
+	line = util.TrimRightSpace(line)
+	if string(line) == f.Delimiter {
+		return true
+	}
+	for _, c := range f.Closers {
+		if string(line) == c {
+			return true
+		}
+	}
+	return false
+}
+
+// YAMLFrontMatter returns a FrontMatterFormat for YAML front matter
+// delimited by '---'. goldmark does not include a YAML decoder, so
+// unmarshal (e.g. yaml.Unmarshal of gopkg.in/yaml.v3) must be supplied by
+// the caller.
+func YAMLFrontMatter(unmarshal func(data []byte, v interface{}) error) FrontMatterFormat {
+	return FrontMatterFormat{
+		Name:      "yaml",
+		Delimiter: "---",
+		Closers:   []string{"..."},
+		Unmarshal: unmarshal,
+	}
+}
+
+// JSONFrontMatter is a FrontMatterFormat for JSON front matter delimited by ';;;'.
+var JSONFrontMatter = FrontMatterFormat{
+	Name:      "json",
+	Delimiter: ";;;",
+	Unmarshal: json.Unmarshal,
+}
+
+// TOMLFrontMatter returns a FrontMatterFormat for TOML front matter
+// delimited by '+++'. goldmark does not include a TOML decoder, so
+// unmarshal must be supplied by the caller.
+func TOMLFrontMatter(unmarshal func(data []byte, v interface{}) error) FrontMatterFormat {
+	return FrontMatterFormat{
+		Name:      "toml",
+		Delimiter: "+++",
+		Unmarshal: unmarshal,
+	}
+}
+
+// A FrontMatterConfig struct is a data structure that holds configuration of the
+// FrontMatter extension.
+type FrontMatterConfig struct {
+	// Formats is a list of recognized front matter formats.
+	Formats []FrontMatterFormat
+
+	// TitleKey is a metadata key whose value is inserted as a level 1
+	// heading at the start of the document, if any.
+	TitleKey string
+
+	// HeadingOffsetKey is a metadata key whose integer value is added to
+	// the level of every heading in the document, if any.
+	HeadingOffsetKey string
+}
+
+// NewFrontMatterConfig returns a new FrontMatterConfig with defaults.
+// By default, YAML front matter is recognized but not decoded.
+func NewFrontMatterConfig() FrontMatterConfig {
+	return FrontMatterConfig{
+		Formats: []FrontMatterFormat{YAMLFrontMatter(nil)},
+	}
+}
+
+const (
+	optFrontMatterFormats       parser.OptionName = "FrontMatterFormats"
+	optFrontMatterTitle         parser.OptionName = "FrontMatterTitle"
+	optFrontMatterHeadingOffset parser.OptionName = "FrontMatterHeadingOffset"
+)
+
+// SetOption implements SetOptioner.
+func (c *FrontMatterConfig) SetOption(name parser.OptionName, value interface{}) {
+	switch name {
+	case optFrontMatterFormats:
+		c.Formats = value.([]FrontMatterFormat)
+	case optFrontMatterTitle:
+		c.TitleKey = value.(string)
+	case optFrontMatterHeadingOffset:
+		c.HeadingOffsetKey = value.(string)
+	}
+}
+
+// A FrontMatterOption interface sets options for the FrontMatter extension.
+type FrontMatterOption interface {
+	parser.Option
+	SetFrontMatterOption(*FrontMatterConfig)
+}
+
+type withFrontMatterFormats struct {
+	value []FrontMatterFormat
+}
+
+func (o *withFrontMatterFormats) SetParserOption(c *parser.Config) {
+	c.Options[optFrontMatterFormats] = o.value
+}
+
+func (o *withFrontMatterFormats) SetFrontMatterOption(c *FrontMatterConfig) {
+	c.Formats = o.value
+}
+
+// WithFrontMatterFormats is a functional option that specifies the
+// recognized front matter formats. By default, only YAML front matter
+// is recognized, and it is not decoded.
+func WithFrontMatterFormats(formats ...FrontMatterFormat) FrontMatterOption {
+	return &withFrontMatterFormats{
+		value: formats,
+	}
+}
+
+type withFrontMatterTitle struct {
+	value string
+}
+
+func (o *withFrontMatterTitle) SetParserOption(c *parser.Config) {
+	c.Options[optFrontMatterTitle] = o.value
+}
+
+func (o *withFrontMatterTitle) SetFrontMatterOption(c *FrontMatterConfig) {
+	c.TitleKey = o.value
+}
+
+// WithFrontMatterTitle is a functional option that inserts the string value
+// of the given metadata key as a level 1 heading at the start of the document,
+// unless the document already starts with a level 1 heading.
+func WithFrontMatterTitle(key string) FrontMatterOption {
+	return &withFrontMatterTitle{
+		value: key,
+	}
+}
+
+type withFrontMatterHeadingOffset struct {
+	value string
+}
+
+func (o *withFrontMatterHeadingOffset) SetParserOption(c *parser.Config) {
+	c.Options[optFrontMatterHeadingOffset] = o.value
+}
+
+func (o *withFrontMatterHeadingOffset) SetFrontMatterOption(c *FrontMatterConfig) {
+	c.HeadingOffsetKey = o.value
+}
+
+// WithFrontMatterHeadingOffset is a functional option that adds the integer
+// value of the given metadata key to the level of every heading in the
+// document. Levels are clamped to the range 1-6.
+func WithFrontMatterHeadingOffset(key string) FrontMatterOption {
+	return &withFrontMatterHeadingOffset{
+		value: key,
+	}
+}
+
+var frontMatterFormatKey = parser.NewContextKey()
+
+type frontMatterParser struct {
+	FrontMatterConfig
+}
+
+// NewFrontMatterParser returns a new BlockParser that
+// parses front matter at the start of a document.
+func NewFrontMatterParser(opts ...FrontMatterOption) parser.BlockParser {
+	p := &frontMatterParser{
+		FrontMatterConfig: NewFrontMatterConfig(),
+	}
+	for _, o := range opts {
+		o.SetFrontMatterOption(&p.FrontMatterConfig)
+	}
+	return p
+}
+
+func (b *frontMatterParser) Trigger() []byte {
+	var triggers []byte
+	for _, f := range b.Formats {
+		if len(f.Delimiter) != 0 && bytes.IndexByte(triggers, f.Delimiter[0]) < 0 {
+			triggers = append(triggers, f.Delimiter[0])
+		}
+	}
+	return triggers
+}
+
+func (b *frontMatterParser) Open(parent gast.Node, reader text.Reader, pc parser.Context) (gast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	if parent.Kind() != gast.KindDocument || parent.ChildCount() != 0 || segment.Start != 0 {
+		return nil, parser.NoChildren
+	}
+	for i := range b.Formats {
+		format := &b.Formats[i]
+		if string(util.TrimRightSpace(line)) != format.Delimiter {
+			continue
+		}
+		// Front matter must be closed, otherwise the delimiter is an ordinary line.
+		if !b.isClosed(format, reader.Source()[segment.Stop:]) {
+			return nil, parser.NoChildren
+		}
+		node := ast.NewFrontMatter(format.Name)
+		node.Opening = segment
+		pc.Set(frontMatterFormatKey, format)
+		reader.Advance(segment.Len() - 1)
+		return node, parser.NoChildren
+	}
+	return nil, parser.NoChildren
+}
+
+func (b *frontMatterParser) isClosed(format *FrontMatterFormat, rest []byte) bool {
+	for len(rest) > 0 {
+		i := bytes.IndexByte(rest, '\n')
+		if i < 0 {
+			return format.isCloser(rest)
+		}
+		if format.isCloser(rest[:i]) {
+			return true
+		}
+		rest = rest[i+1:]
+	}
+	return false
+}
+
+func (b *frontMatterParser) Continue(node gast.Node, reader text.Reader, pc parser.Context) parser.State {
+	line, segment := reader.PeekLine()
+	if line == nil {
+		return parser.Close
+	}
+	format := pc.Get(frontMatterFormatKey).(*FrontMatterFormat)
+	if format.isCloser(line) {
+		node.(*ast.FrontMatter).Closing = segment
+		reader.Advance(segment.Len() - 1)
+		return parser.Close
+	}
+	node.Lines().Append(segment)
+	reader.Advance(segment.Len() - 1)
+	return parser.Continue | parser.NoChildren
+}
+
+func (b *frontMatterParser) Close(node gast.Node, reader text.Reader, pc parser.Context) {
+	format := pc.Get(frontMatterFormatKey).(*FrontMatterFormat)
+	pc.Set(frontMatterFormatKey, nil)
+	if format.Unmarshal == nil {
+		return
+	}
+
+	fm := node.(*ast.FrontMatter)
+	lines := node.Lines()
+	var buf bytes.Buffer
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		buf.Write(segment.Value(reader.Source()))
+	}
+
+	meta := map[string]interface{}{}
+	if err := format.Unmarshal(buf.Bytes(), &meta); err != nil {
+		parser.AddDiagnostic(pc, parser.Diagnostic{
+			Severity: parser.DiagnosticError,
+			Segment:  frontMatterErrorSegment(fm, err),
+			Message:  fmt.Sprintf("malformed %s front matter: %v", format.Name, err),
+		})
+		return
+	}
+	if doc, ok := node.Parent().(*gast.Document); ok {
+		doc.SetMeta(meta)
+	}
+}
+
+func (b *frontMatterParser) CanInterruptParagraph() bool {
+	return false
+}
+
+func (b *frontMatterParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+// errorLineRegexp matches line numbers in errors of decoders like
+// gopkg.in/yaml.v3 that do not have typed errors.
+var errorLineRegexp = regexp.MustCompile(`line (\d+)`)
+
+// frontMatterErrorSegment returns the segment of the line that caused err,
+// or the opening line if the position of the error is unknown.
+func frontMatterErrorSegment(fm *ast.FrontMatter, err error) text.Segment {
+	lines := fm.Lines()
+
+	var syntaxError *json.SyntaxError
+	var typeError *json.UnmarshalTypeError
+	offset := -1
+	switch {
+	case errors.As(err, &syntaxError):
+		offset = int(syntaxError.Offset) - 1
+	case errors.As(err, &typeError):
+		offset = int(typeError.Offset) - 1
+	default:
+		if m := errorLineRegexp.FindStringSubmatch(err.Error()); m != nil {
+			if n, _ := strconv.Atoi(m[1]); n > 0 && n <= lines.Len() {
+				return lines.At(n - 1)
+			}
+		}
+	}
+	if offset >= 0 {
+		for i := 0; i < lines.Len(); i++ {
+			segment := lines.At(i)
+			if offset < segment.Len() {
+				return segment.WithStart(segment.Start + offset)
+			}
+			offset -= segment.Len()
+		}
+	}
+	return fm.Opening
+}
+
+type frontMatterASTTransformer struct {
+	FrontMatterConfig
+}
+
+// NewFrontMatterASTTransformer returns a new parser.ASTTransformer that
+// applies metadata driven options of the FrontMatter extension.
+func NewFrontMatterASTTransformer(opts ...FrontMatterOption) parser.ASTTransformer {
+	t := &frontMatterASTTransformer{
+		FrontMatterConfig: NewFrontMatterConfig(),
+	}
+	for _, o := range opts {
+		o.SetFrontMatterOption(&t.FrontMatterConfig)
+	}
+	return t
+}
+
+func (t *frontMatterASTTransformer) CanTransformLazily() bool {
+	return true
+}
+
+func (t *frontMatterASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
+	if t.HeadingOffsetKey != "" {
+		if offset, ok := frontMatterInt(node.Meta()[t.HeadingOffsetKey]); ok && offset != 0 {
+			_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+				if h, ok := n.(*gast.Heading); ok && entering {
+					h.Level = min(max(h.Level+offset, 1), 6)
+				}
+				return gast.WalkContinue, nil
+			})
+		}
+	}
+
+	if t.TitleKey != "" {
+		title, ok := node.Meta()[t.TitleKey].(string)
+		if !ok || title == "" {
+			return
+		}
+		var fm gast.Node
+		first := node.FirstChild()
+		if first != nil && first.Kind() == ast.KindFrontMatter {
+			fm, first = first, first.NextSibling()
+		}
+		if h, ok := first.(*gast.Heading); ok && h.Level == 1 {
+			return
+		}
+		heading := gast.NewHeading(false, 1)
+		heading.AppendChild(heading, gast.NewString([]byte(title)))
+		if fm != nil {
+			node.InsertAfter(node, fm, heading)
+		} else {
+			node.InsertBefore(node, first, heading)
+		}
+	}
+}
+
+func frontMatterInt(v interface{}) (int, bool) {
+	switch v := v.(type) {
+	case int:
+		return v, true
+	case int64:
+		return int(v), true
+	case uint64:
+		return int(v), true
+	case float64:
+		return int(v), float64(int(v)) == v
+	}
+	return 0, false
+}
+
+// FrontMatterMarkdownRenderer is a renderer.NodeRenderer implementation that
+// writes FrontMatter nodes back to Markdown using a markdown.Renderer.
+// Like other Markdown renderers, it is not registered by the FrontMatter
+// extension; it must be added to a renderer with the markdown.Renderer.
+type FrontMatterMarkdownRenderer struct {
+	*markdown.Renderer
+}
+
+// NewFrontMatterMarkdownRenderer returns a new FrontMatterMarkdownRenderer
+// that writes through the given markdown.Renderer.
+func NewFrontMatterMarkdownRenderer(r *markdown.Renderer) renderer.NodeRenderer {
+	return &FrontMatterMarkdownRenderer{r}
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *FrontMatterMarkdownRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindFrontMatter, r.renderFrontMatter)
+}
+
+func (r *FrontMatterMarkdownRenderer) renderFrontMatter(
+	w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		if err := r.CloseBlock(w); err != nil {
+			return gast.WalkStop, err
+		}
+		return gast.WalkContinue, nil
+	}
+
+	if err := r.OpenBlock(w, source, n); err != nil {
+		return gast.WalkStop, err
+	}
+
+	fm := n.(*ast.FrontMatter)
+	if _, err := r.Write(w, fm.Opening.Value(source)); err != nil {
+		return gast.WalkStop, err
+	}
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		if _, err := r.Write(w, segment.Value(source)); err != nil {
+			return gast.WalkStop, err
+		}
+	}
+	if _, err := r.Write(w, fm.Closing.Value(source)); err != nil {
+		return gast.WalkStop, err
+	}
+	return gast.WalkSkipChildren, nil
+}
+
+type frontMatter struct {
+	options []FrontMatterOption
+}
+
+// FrontMatter is an extension that recognizes YAML front matter.
+//
+// FrontMatter only extracts the raw bytes of the front matter into an
+// ast.FrontMatter node: goldmark does not include a YAML decoder, so the
+// Meta of the document stays empty, and WithFrontMatterTitle and
+// WithFrontMatterHeadingOffset have no effect. To decode front matter, use
+// NewFrontMatter with a decoder, e.g.
+//
+//	NewFrontMatter(WithFrontMatterFormats(YAMLFrontMatter(yaml.Unmarshal)))
+var FrontMatter = &frontMatter{}
+
+// NewFrontMatter returns a new extension with the given options. Front
+// matter is decoded only in the formats given to WithFrontMatterFormats
+// that have an Unmarshal function.
+func NewFrontMatter(opts ...FrontMatterOption) goldmark.Extender {
+	return &frontMatter{
+		options: opts,
+	}
+}
+
+func (e *frontMatter) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(
+			util.Prioritized(NewFrontMatterParser(e.options...), 0),
+		),
+		parser.WithASTTransformers(
+			util.Prioritized(NewFrontMatterASTTransformer(e.options...), 0),
+		),
+	)
+}
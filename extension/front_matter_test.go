@@ -0,0 +1,161 @@
+package extension
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/pgavlin/goldmark"
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/extension/ast"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/testutil"
+	"github.com/pgavlin/goldmark/text"
+)
+
+// testYAMLUnmarshal decodes the subset of YAML used by the tests: one
+// 'key: value' pair per line, where a value is an integer, a string or a
+// flow sequence of strings like '[a, b]'.
+func testYAMLUnmarshal(data []byte, v interface{}) error {
+	meta := *v.(*map[string]interface{})
+	for i, line := range strings.Split(strings.TrimSuffix(string(data), "\n"), "\n") {
+		key, value, ok := strings.Cut(line, ": ")
+		if !ok || key == "" || strings.HasPrefix(key, " ") {
+			return fmt.Errorf("yaml: line %d: could not find expected ':'", i+1)
+		}
+		if n, err := strconv.Atoi(value); err == nil {
+			meta[key] = n
+		} else if strings.HasPrefix(value, "[") && strings.HasSuffix(value, "]") {
+			items := []interface{}{}
+			for _, item := range strings.Split(value[1:len(value)-1], ",") {
+				items = append(items, strings.TrimSpace(item))
+			}
+			meta[key] = items
+		} else {
+			meta[key] = value
+		}
+	}
+	return nil
+}
+
+func TestFrontMatter(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			FrontMatter,
+		),
+	)
+	testutil.DoTestCaseFile(markdown, "_test/front_matter.txt", t, testutil.ParseCliCaseArg()...)
+}
+
+func TestFrontMatterMeta(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewFrontMatter(
+				WithFrontMatterFormats(YAMLFrontMatter(testYAMLUnmarshal), JSONFrontMatter),
+			),
+		),
+	)
+
+	cases := []struct {
+		source   string
+		expected map[string]interface{}
+	}{
+		{"---\ntitle: Hello\ntags: [a, b]\n---\n", map[string]interface{}{
+			"title": "Hello",
+			"tags":  []interface{}{"a", "b"},
+		}},
+		{";;;\n{\"title\": \"Hello\", \"weight\": 2}\n;;;\n", map[string]interface{}{
+			"title":  "Hello",
+			"weight": float64(2),
+		}},
+	}
+	for _, c := range cases {
+		doc := markdown.Parser().Parse(text.NewReader([]byte(c.source))).(*gast.Document)
+		if !reflect.DeepEqual(c.expected, doc.Meta()) {
+			t.Errorf("%q: expected %v, got %v", c.source, c.expected, doc.Meta())
+		}
+	}
+}
+
+func TestFrontMatterWithoutDecoder(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			FrontMatter,
+		),
+	)
+	source := []byte("---\ntitle: Hello\n  bad: [\n---\n")
+	pc := parser.NewContext()
+	doc := markdown.Parser().Parse(text.NewReader(source), parser.WithContext(pc)).(*gast.Document)
+	if doc.FirstChild() == nil || doc.FirstChild().Kind() != ast.KindFrontMatter {
+		t.Fatal("expected YAML front matter to be recognized")
+	}
+	if len(doc.Meta()) != 0 || len(parser.Diagnostics(pc)) != 0 {
+		t.Errorf("expected YAML front matter not to be decoded, got %v", doc.Meta())
+	}
+}
+
+func TestFrontMatterDiagnostics(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewFrontMatter(
+				WithFrontMatterFormats(YAMLFrontMatter(testYAMLUnmarshal), JSONFrontMatter),
+			),
+		),
+	)
+
+	cases := []struct {
+		source   string
+		expected string
+	}{
+		{"---\ntitle: Hello\n  bad: [\n---\n", "3:1: error: malformed yaml front matter"},
+		{";;;\n{\n  \"title\": Hello\n}\n;;;\n", "3:12: error: malformed json front matter"},
+	}
+	for _, c := range cases {
+		source := []byte(c.source)
+		pc := parser.NewContext()
+		doc := markdown.Parser().Parse(text.NewReader(source), parser.WithContext(pc)).(*gast.Document)
+		diagnostics := parser.Diagnostics(pc)
+		if len(diagnostics) != 1 {
+			t.Errorf("%q: expected 1 diagnostic, got %d", c.source, len(diagnostics))
+			continue
+		}
+		if actual := diagnostics[0].Format(source); !strings.HasPrefix(actual, c.expected) {
+			t.Errorf("%q: expected %q, got %q", c.source, c.expected, actual)
+		}
+		if len(doc.Meta()) != 0 {
+			t.Errorf("%q: expected no metadata, got %v", c.source, doc.Meta())
+		}
+	}
+}
+
+func TestFrontMatterTitleAndHeadingOffset(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewFrontMatter(
+				WithFrontMatterFormats(YAMLFrontMatter(testYAMLUnmarshal)),
+				WithFrontMatterTitle("title"),
+				WithFrontMatterHeadingOffset("heading-offset"),
+			),
+		),
+	)
+	testutil.DoTestCase(
+		markdown,
+		testutil.MarkdownTestCase{
+			No:          1,
+			Description: "Title and heading offset from front matter",
+			Markdown: `---
+title: Hello
+heading-offset: 1
+---
+# Section
+###### Deep
+`,
+			Expected: `<h1>Hello</h1>
+<h2>Section</h2>
+<h6>Deep</h6>`,
+		},
+		t,
+	)
+}
@@ -15,4 +15,5 @@ func (e *gfm) Extend(m goldmark.Markdown) {
 	Table.Extend(m)
 	Strikethrough.Extend(m)
 	TaskList.Extend(m)
+	TagFilter.Extend(m)
 }
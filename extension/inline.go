@@ -0,0 +1,100 @@
+package extension
+
+import (
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/text"
+)
+
+// nestedInlineContextKeys are the keys of values that are shared between a
+// context and the contexts of the inlines nested in it.
+var nestedInlineContextKeys []parser.ContextKey
+
+// shareWithNestedInlines registers the given key, so that its value is
+// shared with the contexts of nested inlines like labels of inline directives
+// and notes of inline footnotes, and returns it.
+func shareWithNestedInlines(key parser.ContextKey) parser.ContextKey {
+	nestedInlineContextKeys = append(nestedInlineContextKeys, key)
+	return key
+}
+
+// nestedInlineContext is the Context of nested inlines. It has its own
+// values and delimiters, but it looks up references, resolves them and
+// reports diagnostics with the Context of the enclosing block, so that
+// references defined in other documents of a parser.Session are found too.
+type nestedInlineContext struct {
+	parser.Context
+	parent parser.Context
+}
+
+func newNestedInlineContext(pc parser.Context) *nestedInlineContext {
+	c := &nestedInlineContext{
+		Context: parser.NewContext(parser.WithIDs(pc.IDs())),
+		parent:  pc,
+	}
+	if ac, ok := pc.(parser.ArenaContext); ok {
+		c.SetArena(ac.Arena())
+	}
+	for _, key := range nestedInlineContextKeys {
+		c.Set(key, pc.Get(key))
+	}
+	return c
+}
+
+func (c *nestedInlineContext) AddReference(ref parser.Reference) {
+	c.parent.AddReference(ref)
+}
+
+func (c *nestedInlineContext) Reference(label string) (parser.Reference, bool) {
+	return c.parent.Reference(label)
+}
+
+func (c *nestedInlineContext) References() []parser.Reference {
+	return c.parent.References()
+}
+
+func (c *nestedInlineContext) ResolveReference(label string) (parser.Reference, bool) {
+	return parser.ResolveReference(c.parent, label)
+}
+
+func (c *nestedInlineContext) AddDiagnostic(d parser.Diagnostic) {
+	parser.AddDiagnostic(c.parent, d)
+}
+
+func (c *nestedInlineContext) Diagnostics() []parser.Diagnostic {
+	return parser.Diagnostics(c.parent)
+}
+
+func (c *nestedInlineContext) Arena() *parser.Arena {
+	return c.Context.(parser.ArenaContext).Arena()
+}
+
+func (c *nestedInlineContext) SetArena(arena *parser.Arena) {
+	c.Context.(parser.ArenaContext).SetArena(arena)
+}
+
+// parseNestedInlines parses the given segments into children of the given
+// node with the given parser. The segments are parsed in a nested context,
+// because the context of the enclosing block holds delimiters that must not
+// be processed with the segments, but the nested context shares references,
+// IDs, diagnostics and the values of the keys registered with
+// shareWithNestedInlines with the given one.
+func parseNestedInlines(p parser.Parser, node gast.Node, segments *text.Segments, source []byte, pc parser.Context) {
+	npc := newNestedInlineContext(pc)
+	block := parser.ParseInline(p, text.NewBlockReader(source, segments), parser.WithContext(npc))
+	if block == nil {
+		// p can not parse inline contents, so segments are kept as text.
+		for i := 0; i < segments.Len(); i++ {
+			node.AppendChild(node, gast.NewTextSegment(segments.At(i)))
+		}
+		return
+	}
+	for c := block.FirstChild(); c != nil; {
+		next := c.NextSibling()
+		node.AppendChild(node, c)
+		c = next
+	}
+	for _, key := range nestedInlineContextKeys {
+		pc.Set(key, npc.Get(key))
+	}
+}
@@ -0,0 +1,117 @@
+package extension
+
+import (
+	"github.com/pgavlin/goldmark"
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/extension/ast"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/text"
+	"github.com/pgavlin/goldmark/util"
+)
+
+var inlineAttributesFoundKey = parser.NewContextKey()
+
+type inlineAttributesParser struct {
+}
+
+var defaultInlineAttributesParser = &inlineAttributesParser{}
+
+// NewInlineAttributesParser returns a new InlineParser that parses
+// attributes like '{#id .class key=value}' that immediately follow
+// links, images, emphasis and code spans.
+func NewInlineAttributesParser() parser.InlineParser {
+	return defaultInlineAttributesParser
+}
+
+func (s *inlineAttributesParser) Trigger() []byte {
+	return []byte{'{'}
+}
+
+// CanParseConcurrently returns false, because ids of inline attributes are
+// reserved with Context.IDs, so they must be reserved in the order of the
+// document.
+func (s *inlineAttributesParser) CanParseConcurrently() bool {
+	return false
+}
+
+func (s *inlineAttributesParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	// Emphasis has not been resolved yet, so the preceding node may still
+	// be a delimiter. Text and whitespace can never have attributes.
+	prev := parent.LastChild()
+	if prev == nil {
+		return nil
+	}
+	if _, ok := prev.(*parser.Delimiter); !ok && !canHaveInlineAttributes(prev) {
+		return nil
+	}
+
+	_, start := block.Position()
+	attrs, ok := parser.ParseAttributes(block)
+	if !ok || len(attrs) == 0 {
+		return nil
+	}
+	_, stop := block.Position()
+
+	node := ast.NewInlineAttributes(text.NewSegment(start.Start, stop.Start))
+	for _, attr := range attrs {
+		node.SetAttribute(attr.Name, attr.Value)
+	}
+	pc.Set(inlineAttributesFoundKey, true)
+	return node
+}
+
+// CloseBlock moves attributes to the elements they follow once emphasis
+// has been resolved. Attributes that do not follow an element are
+// turned back into text.
+func (s *inlineAttributesParser) CloseBlock(parent gast.Node, block text.Reader, pc parser.Context) {
+	if pc.Get(inlineAttributesFoundKey) == nil {
+		return
+	}
+	pc.Set(inlineAttributesFoundKey, nil)
+
+	// The inlines of parent may be being parsed lazily, so we walk its
+	// children: walking parent would parse them again.
+	var nodes []*ast.InlineAttributes
+	for c := parent.FirstChild(); c != nil; c = c.NextSibling() {
+		_ = gast.Walk(c, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+			if a, ok := n.(*ast.InlineAttributes); ok && entering {
+				nodes = append(nodes, a)
+			}
+			return gast.WalkContinue, nil
+		})
+	}
+
+	for _, n := range nodes {
+		p := n.Parent()
+		prev := n.PreviousSibling()
+		if prev == nil || !canHaveInlineAttributes(prev) {
+			gast.MergeOrReplaceTextSegment(p, n, n.Segment)
+			continue
+		}
+		for _, attr := range n.Attributes() {
+			setAttribute(prev, attr, pc)
+		}
+		p.RemoveChild(p, n)
+	}
+}
+
+func canHaveInlineAttributes(n gast.Node) bool {
+	switch n.Kind() {
+	case gast.KindText, gast.KindString, gast.KindWhitespace, gast.KindRawHTML, ast.KindInlineAttributes:
+		return false
+	}
+	return n.Type() == gast.TypeInline
+}
+
+type inlineAttributes struct {
+}
+
+// InlineAttributes is an extension that allows you to set attributes on
+// links, images, emphasis and code spans like '[text](url){target=_blank}'.
+var InlineAttributes = &inlineAttributes{}
+
+func (e *inlineAttributes) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(NewInlineAttributesParser(), 600),
+	))
+}
@@ -0,0 +1,54 @@
+package extension
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pgavlin/goldmark"
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/testutil"
+	"github.com/pgavlin/goldmark/text"
+)
+
+func TestInlineAttributes(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			InlineAttributes,
+		),
+	)
+	testutil.DoTestCaseFile(markdown, "_test/inline_attributes.txt", t, testutil.ParseCliCaseArg()...)
+}
+
+func TestInlineAttributesIDs(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			InlineAttributes,
+		),
+	)
+	for _, opts := range [][]parser.ParseOption{nil, {parser.WithParallelInlines(2)}} {
+		pc := parser.NewContext()
+		source := []byte("[a](/b){#intro}\n\n*c*{#other}\n")
+		markdown.Parser().Parse(text.NewReader(source), append(opts, parser.WithContext(pc))...)
+		if id := pc.IDs().Generate([]byte("intro"), gast.KindHeading); string(id) != "intro-1" {
+			t.Errorf("expected inline ids to be used, got %q", id)
+		}
+		if id := pc.IDs().Generate([]byte("other"), gast.KindHeading); string(id) != "other-1" {
+			t.Errorf("expected inline ids to be used, got %q", id)
+		}
+	}
+}
+
+func TestInlineAttributesLazyInlines(t *testing.T) {
+	md := goldmark.New(goldmark.WithExtensions(InlineAttributes))
+	source := []byte("*a*{.b} and [c](/d){#e}\n")
+	doc := md.Parser().Parse(text.NewReader(source), parser.WithLazyInlines())
+	var buf bytes.Buffer
+	if err := md.Renderer().Render(&buf, source, doc); err != nil {
+		t.Fatal(err)
+	}
+	expected := "<p><em class=\"b\">a</em> and <a href=\"/d\" id=\"e\">c</a></p>\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
@@ -160,6 +160,10 @@ func (s *linkifyParser) Trigger() []byte {
 	return []byte{' ', '*', '_', '~', '('}
 }
 
+func (s *linkifyParser) CanParseConcurrently() bool {
+	return true
+}
+
 var (
 	protoHTTP  = []byte("http:")
 	protoHTTPS = []byte("https:")
@@ -0,0 +1,361 @@
+package extension
+
+import (
+	"bytes"
+	"regexp"
+
+	"github.com/pgavlin/goldmark"
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/extension/ast"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/renderer"
+	"github.com/pgavlin/goldmark/renderer/html"
+	"github.com/pgavlin/goldmark/renderer/markdown"
+	"github.com/pgavlin/goldmark/text"
+	"github.com/pgavlin/goldmark/util"
+)
+
+var markdownInHTMLOpeningTagRegexp = regexp.MustCompile(`^<([A-Za-z][A-Za-z0-9-]*)((?:\s+[A-Za-z_:][A-Za-z0-9_.:-]*(?:\s*=\s*(?:[^\s"'=<>` + "`" + `]+|'[^']*'|"[^"]*"))?)*)\s*>`) //nolint:golint,lll
+var markdownInHTMLAttributeRegexp = regexp.MustCompile(`\s+([A-Za-z_:][A-Za-z0-9_.:-]*)(?:\s*=\s*([^\s"'=<>` + "`" + `]+|'[^']*'|"[^"]*"))?`)
+
+// markdownInHTMLSpanTags is a set of tags whose contents are inlines if the
+// value of their markdown attribute is "1".
+var markdownInHTMLSpanTags = map[string]bool{
+	"caption":    true,
+	"dt":         true,
+	"figcaption": true,
+	"h1":         true,
+	"h2":         true,
+	"h3":         true,
+	"h4":         true,
+	"h5":         true,
+	"h6":         true,
+	"legend":     true,
+	"p":          true,
+	"summary":    true,
+}
+
+// findMarkdownAttribute returns a position of the markdown attribute in the
+// given attributes of an opening tag, and whether the contents are inlines.
+func findMarkdownAttribute(attrs []byte, tag []byte) (start, stop int, isSpan, ok bool) {
+	for _, m := range markdownInHTMLAttributeRegexp.FindAllSubmatchIndex(attrs, -1) {
+		if !bytes.EqualFold(attrs[m[2]:m[3]], []byte("markdown")) || m[4] < 0 {
+			continue
+		}
+		value := attrs[m[4]:m[5]]
+		if value[0] == '"' || value[0] == '\'' {
+			value = value[1 : len(value)-1]
+		}
+		switch string(value) {
+		case "1":
+			return m[0], m[1], markdownInHTMLSpanTags[string(tag)], true
+		case "block":
+			return m[0], m[1], false, true
+		case "span":
+			return m[0], m[1], true, true
+		}
+		return 0, 0, false, false
+	}
+	return 0, 0, false, false
+}
+
+// matchClosingTag returns a length of the closing tag of the given tag at the
+// start of the given line, or -1 if there is no such tag.
+func matchClosingTag(line []byte, tag []byte) int {
+	if len(line) < len(tag)+3 || line[0] != '<' || line[1] != '/' ||
+		!bytes.EqualFold(line[2:2+len(tag)], tag) {
+		return -1
+	}
+	i := 2 + len(tag)
+	i += util.TrimLeftSpaceLength(line[i:])
+	if i == len(line) || line[i] != '>' {
+		return -1
+	}
+	return i + 1
+}
+
+// countOpenTags returns a number of opening tags minus a number of closing
+// tags with the given name in the given HTML. Self-closing tags are ignored.
+func countOpenTags(html []byte, tag []byte) int {
+	depth := 0
+	for i := bytes.IndexByte(html, '<'); i >= 0; i = bytes.IndexByte(html, '<') {
+		html = html[i+1:]
+		closing := len(html) != 0 && html[0] == '/'
+		name := html
+		if closing {
+			name = name[1:]
+		}
+		if len(name) <= len(tag) || !bytes.EqualFold(name[:len(tag)], tag) {
+			continue
+		}
+		if c := name[len(tag)]; !util.IsSpace(c) && c != '>' && c != '/' {
+			continue
+		}
+		if closing {
+			depth--
+		} else if end := bytes.IndexByte(name, '>'); end < 0 || name[end-1] != '/' {
+			depth++
+		}
+	}
+	return depth
+}
+
+type markdownInHTMLParser struct {
+}
+
+var defaultMarkdownInHTMLParser = &markdownInHTMLParser{}
+
+// NewMarkdownInHTMLParser returns a new BlockParser that parses block-level
+// HTML elements with a markdown attribute, like '<div markdown="1">', into
+// ast.HTMLElement nodes.
+func NewMarkdownInHTMLParser() parser.BlockParser {
+	return defaultMarkdownInHTMLParser
+}
+
+func (b *markdownInHTMLParser) Trigger() []byte {
+	return []byte{'<'}
+}
+
+func (b *markdownInHTMLParser) Open(parent gast.Node, reader text.Reader, pc parser.Context) (gast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 || line[pos] != '<' {
+		return nil, parser.NoChildren
+	}
+	m := markdownInHTMLOpeningTagRegexp.FindSubmatchIndex(line[pos:])
+	if m == nil {
+		return nil, parser.NoChildren
+	}
+	tag := bytes.ToLower(line[pos+m[2] : pos+m[3]])
+	if !parser.IsHTMLBlockTag(tag) {
+		return nil, parser.NoChildren
+	}
+	attrStart, attrStop, isSpan, ok := findMarkdownAttribute(line[pos+m[4]:pos+m[5]], tag)
+	if !ok {
+		return nil, parser.NoChildren
+	}
+	// Contents of block elements start on the next line.
+	if !isSpan && !util.IsBlank(line[pos+m[1]:]) {
+		return nil, parser.NoChildren
+	}
+
+	node := ast.NewHTMLElement(tag, isSpan)
+	start := segment.Start + pos - segment.Padding
+	node.OpeningTag = text.NewSegment(start, start+m[1])
+	node.MarkdownAttribute = text.NewSegment(start+m[4]+attrStart, start+m[4]+attrStop)
+	if !isSpan {
+		reader.AdvanceToEOL()
+		return node, parser.HasChildren
+	}
+	reader.Advance(pos + m[1] - segment.Padding)
+	b.appendSpanLine(node, reader)
+	return node, parser.NoChildren
+}
+
+// appendSpanLine appends the current line to the given span element up to
+// its closing tag, if any.
+func (b *markdownInHTMLParser) appendSpanLine(node *ast.HTMLElement, reader text.Reader) {
+	line, segment := reader.PeekLine()
+	trimmed := util.TrimRightSpace(line)
+	if i := bytes.LastIndex(bytes.ToLower(trimmed), append([]byte("</"), node.Tag...)); i >= 0 {
+		if l := matchClosingTag(trimmed[i:], node.Tag); i+l == len(trimmed) {
+			start := segment.Start + i - segment.Padding
+			node.ClosingTag = text.NewSegment(start, start+l)
+			segment = segment.WithStop(start)
+		}
+	}
+	if !segment.IsEmpty() && !util.IsBlank(segment.Value(reader.Source())) {
+		node.Lines().Append(segment)
+	}
+	reader.AdvanceToEOL()
+}
+
+func (b *markdownInHTMLParser) Continue(node gast.Node, reader text.Reader, pc parser.Context) parser.State {
+	n := node.(*ast.HTMLElement)
+	if n.HasClosingTag() {
+		return parser.Close
+	}
+	line, segment := reader.PeekLine()
+	if n.IsSpan {
+		if util.IsBlank(line) {
+			return parser.Close
+		}
+		b.appendSpanLine(n, reader)
+		return parser.Continue | parser.NoChildren
+	}
+
+	w, pos := util.IndentWidth(line, reader.LineOffset())
+	if w < 4 {
+		if l := matchClosingTag(line[pos:], n.Tag); l > 0 && util.IsBlank(line[pos+l:]) && !hasOpenTag(n, reader, pc) {
+			start := segment.Start + pos - segment.Padding
+			n.ClosingTag = text.NewSegment(start, start+l)
+			reader.AdvanceToEOL()
+			return parser.Close
+		}
+	}
+	return parser.Continue | parser.HasChildren
+}
+
+// hasOpenTag returns true if a block opened inside the given element has an
+// open tag of the same name, so that a closing tag belongs to that block.
+func hasOpenTag(node *ast.HTMLElement, reader text.Reader, pc parser.Context) bool {
+	inside := false
+	for _, b := range pc.OpenedBlocks() {
+		if b.Node == node {
+			inside = true
+			continue
+		}
+		if !inside {
+			continue
+		}
+		switch c := b.Node.(type) {
+		case *ast.HTMLElement:
+			if !c.IsSpan && bytes.Equal(c.Tag, node.Tag) {
+				return true
+			}
+		case *gast.HTMLBlock:
+			if countOpenTags(c.Lines().Value(reader.Source()), node.Tag) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (b *markdownInHTMLParser) Close(node gast.Node, reader text.Reader, pc parser.Context) {
+	n := node.(*ast.HTMLElement)
+	if n.IsSpan && n.Lines().Len() != 0 {
+		lines := n.Lines()
+		last := lines.At(lines.Len() - 1)
+		lines.Set(lines.Len()-1, last.TrimRightSpace(reader.Source()))
+	}
+}
+
+func (b *markdownInHTMLParser) CanInterruptParagraph() bool {
+	return true
+}
+
+func (b *markdownInHTMLParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+// HTMLElementHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders HTMLElement nodes. Like raw HTML, tags are omitted unless
+// html.WithUnsafe is given.
+type HTMLElementHTMLRenderer struct {
+	html.Config
+}
+
+// NewHTMLElementHTMLRenderer returns a new HTMLElementHTMLRenderer.
+func NewHTMLElementHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	r := &HTMLElementHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *HTMLElementHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindHTMLElement, r.renderHTMLElement)
+}
+
+func (r *HTMLElementHTMLRenderer) renderHTMLElement(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	n := node.(*ast.HTMLElement)
+	if entering {
+		if r.Unsafe {
+			// The attribute that marks the contents as Markdown is not a part of the output.
+			opening := n.OpeningTag
+			if attr := n.MarkdownAttribute; attr.Start >= 0 {
+				_, _ = w.Write(source[opening.Start:attr.Start])
+				_, _ = w.Write(source[attr.Stop:opening.Stop])
+			} else {
+				_, _ = w.Write(opening.Value(source))
+			}
+		} else {
+			_, _ = w.WriteString("<!-- raw HTML omitted -->")
+		}
+		if !n.IsSpan {
+			_ = w.WriteByte('\n')
+		}
+	} else {
+		if r.Unsafe {
+			_, _ = w.WriteString("</")
+			_, _ = w.Write(n.Tag)
+			_ = w.WriteByte('>')
+		} else {
+			_, _ = w.WriteString("<!-- raw HTML omitted -->")
+		}
+		_ = w.WriteByte('\n')
+	}
+	return gast.WalkContinue, nil
+}
+
+// HTMLElementMarkdownRenderer is a renderer.NodeRenderer implementation that
+// writes HTMLElement nodes back to Markdown using a markdown.Renderer.
+type HTMLElementMarkdownRenderer struct {
+	*markdown.Renderer
+}
+
+// NewHTMLElementMarkdownRenderer returns a new HTMLElementMarkdownRenderer
+// that writes through the given markdown.Renderer.
+func NewHTMLElementMarkdownRenderer(r *markdown.Renderer) renderer.NodeRenderer {
+	return &HTMLElementMarkdownRenderer{r}
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *HTMLElementMarkdownRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindHTMLElement, r.renderHTMLElement)
+}
+
+func (r *HTMLElementMarkdownRenderer) renderHTMLElement(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	n := node.(*ast.HTMLElement)
+	if entering {
+		if err := r.OpenBlock(w, source, node); err != nil {
+			return gast.WalkStop, err
+		}
+		if _, err := r.Write(w, n.OpeningTag.Value(source)); err != nil {
+			return gast.WalkStop, err
+		}
+		if !n.IsSpan {
+			if err := r.WriteByte(w, '\n'); err != nil {
+				return gast.WalkStop, err
+			}
+		}
+		return gast.WalkContinue, nil
+	}
+
+	// Children of a block element are blocks, which end with a new line,
+	// so the closing tag of a block element starts on its own line.
+	if n.HasClosingTag() {
+		if _, err := r.Write(w, n.ClosingTag.Value(source)); err != nil {
+			return gast.WalkStop, err
+		}
+	}
+	if err := r.CloseBlock(w); err != nil {
+		return gast.WalkStop, err
+	}
+	return gast.WalkContinue, nil
+}
+
+type markdownInHTML struct {
+}
+
+// MarkdownInHTML is an extension that parses the contents of block-level
+// HTML elements with a markdown attribute as Markdown, like PHP Markdown
+// Extra and kramdown. The value "block" parses the contents as blocks, "span"
+// parses them as inlines, and "1" chooses one of them based on the tag.
+var MarkdownInHTML = &markdownInHTML{}
+
+func (e *markdownInHTML) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithBlockParsers(
+		util.Prioritized(NewMarkdownInHTMLParser(), 850),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewHTMLElementHTMLRenderer(), 500),
+	))
+}
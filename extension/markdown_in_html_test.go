@@ -0,0 +1,43 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/pgavlin/goldmark"
+	"github.com/pgavlin/goldmark/renderer/html"
+	"github.com/pgavlin/goldmark/testutil"
+)
+
+func TestMarkdownInHTML(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithUnsafe(),
+		),
+		goldmark.WithExtensions(
+			MarkdownInHTML,
+		),
+	)
+	testutil.DoTestCaseFile(markdown, "_test/markdown_in_html.txt", t, testutil.ParseCliCaseArg()...)
+}
+
+func TestMarkdownInHTMLSafe(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			MarkdownInHTML,
+		),
+	)
+	testutil.DoTestCase(
+		markdown,
+		testutil.MarkdownTestCase{
+			No:          1,
+			Description: "Tags are omitted without html.WithUnsafe",
+			Markdown: `<div markdown="1">
+*text*
+</div>`,
+			Expected: `<!-- raw HTML omitted -->
+<p><em>text</em></p>
+<!-- raw HTML omitted -->`,
+		},
+		t,
+	)
+}
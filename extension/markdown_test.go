@@ -0,0 +1,150 @@
+package extension
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/pgavlin/goldmark"
+	"github.com/pgavlin/goldmark/renderer"
+	"github.com/pgavlin/goldmark/renderer/markdown"
+	"github.com/pgavlin/goldmark/text"
+	"github.com/pgavlin/goldmark/util"
+)
+
+// TestMarkdownRenderers checks that the Markdown renderers of extensions
+// write documents back as they were.
+func TestMarkdownRenderers(t *testing.T) {
+	bibliography, err := LoadBibliography(os.DirFS("_test"), "citation.bib")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		Name      string
+		Extension goldmark.Extender
+		Renderer  func(*markdown.Renderer) renderer.NodeRenderer
+		Sources   []string
+	}{
+		{
+			Name:      "FrontMatter",
+			Extension: FrontMatter,
+			Renderer:  NewFrontMatterMarkdownRenderer,
+			Sources:   []string{"---\ntitle: Hello\n...\n\n# Heading\n"},
+		},
+		{
+			Name:      "InlineAttributes",
+			Extension: InlineAttributes,
+			Sources: []string{
+				"[text](https://example.com){target=\"_blank\" .btn}\n",
+				"![alt](img.png){width=300}\n",
+				"*x*{.hl .big} and **y**{#strong}\n",
+				"`code`{.lang-go data-x=\"a \\\"b\\\"\"}\n",
+			},
+		},
+		{
+			Name:      "MarkdownInHTML",
+			Extension: MarkdownInHTML,
+			Renderer:  NewHTMLElementMarkdownRenderer,
+			Sources: []string{
+				"<div class=\"note\" markdown=\"1\">\n# Title\n\nSome *text*\n</div>\n",
+				"<p markdown=\"1\">*emph* and\n**strong**</p>\n",
+				"<div markdown=\"1\">\n<div markdown=\"block\">\n> quote\n</div>\n</div>\n",
+			},
+		},
+		{
+			Name:      "Math",
+			Extension: Math,
+			Renderer:  NewMathMarkdownRenderer,
+			Sources: []string{
+				"Let $x_1$ and $\\{a\\}$ be $a\nb$.\n",
+				"$$\n\\frac{1}{2} < 3\n$$\n",
+				"> ```math\n> a_1\n> ```\n",
+				"- $$\n  x\n",
+				"$$ \\frac{1}{2} $$\n",
+				"So $$a\nb$$ and $c$.\n",
+			},
+		},
+		{
+			Name:      "Alert",
+			Extension: NewAlert(WithMkDocsAdmonitions()),
+			Renderer:  NewAlertMarkdownRenderer,
+			Sources: []string{
+				"> [!NOTE]\n> Useful information.\n",
+				"> [!TIP] Title\n> - one\n> - two\n",
+				"!!! note\n    Text\n",
+				"!!! danger \"Don't\"\n    - one\n    !!! tip \"\"\n        Nested\n",
+			},
+		},
+		{
+			Name:      "Directive",
+			Extension: Directive,
+			Renderer: func(mr *markdown.Renderer) renderer.NodeRenderer {
+				return NewDirectiveMarkdownRenderer(mr, nil)
+			},
+			Sources: []string{
+				"The :abbr[*HTML*]{title=\"HyperText Markup Language\"} spec and :badge{.new}.\n",
+				"::youtube[Video]{#cat-video}\n",
+				"::hr\n",
+				":::warning[Be *careful*]{.callout}\nSome text.\n\n- a list\n:::\n",
+				"::::outer\n:::inner\nInner\n:::\n::::\n",
+				"> :::note\n> Text\n> :::\n",
+			},
+		},
+		{
+			Name:      "Emoji",
+			Extension: Emoji,
+			Renderer:  NewEmojiMarkdownRenderer,
+			Sources:   []string{"Ship it :+1: :rocket: and :unknown:\n"},
+		},
+		{
+			Name:      "WikiLink",
+			Extension: NewWikiLink(WithWikiLinkEmbeds()),
+			Renderer:  NewWikiLinkMarkdownRenderer,
+			Sources:   []string{"See [[Page Name]], [[Page#Section|label]], [[#Top]] and ![[image.png]]\n"},
+		},
+		{
+			Name:      "Mention",
+			Extension: NewMention(WithMentionResolver(testMentionResolver)),
+			Renderer:  NewMentionMarkdownRenderer,
+			Sources:   []string{"@alice fixed #123 and org/repo#45 in #release\n"},
+		},
+		{
+			Name:      "Abbreviation",
+			Extension: Abbreviation,
+			Renderer:  NewAbbreviationMarkdownRenderer,
+			Sources:   []string{"The HTML specification is maintained by the W3C.\n\n*[HTML]: Hyper Text Markup Language\n*[W3C]: World Wide Web Consortium\n"},
+		},
+		{
+			Name:      "Citation",
+			Extension: NewCitation(bibliography),
+			Renderer:  NewCitationMarkdownRenderer,
+			Sources:   []string{"@smith2020 [p. 4] and @doe1999 say so [see -@lee2018, ch. 2; @who2021].\n"},
+		},
+		{
+			Name:      "CriticMarkup",
+			Extension: CriticMarkup,
+			Renderer:  NewCriticMarkupMarkdownRenderer,
+			Sources:   []string{criticMarkupSource},
+		},
+	}
+	for _, c := range cases {
+		md := goldmark.New(goldmark.WithExtensions(c.Extension))
+		for i, s := range c.Sources {
+			source := []byte(s)
+			doc := md.Parser().Parse(text.NewReader(source))
+			mr := &markdown.Renderer{}
+			nodeRenderers := []util.PrioritizedValue{util.Prioritized(mr, 100)}
+			if c.Renderer != nil {
+				nodeRenderers = append(nodeRenderers, util.Prioritized(c.Renderer(mr), 100))
+			}
+			r := renderer.NewRenderer(renderer.WithNodeRenderers(nodeRenderers...))
+			var buf bytes.Buffer
+			if err := r.Render(&buf, source, doc); err != nil {
+				t.Fatalf("%s %d: %v", c.Name, i+1, err)
+			}
+			if buf.String() != s {
+				t.Errorf("%s %d: expected %q, got %q", c.Name, i+1, s, buf.String())
+			}
+		}
+	}
+}
@@ -0,0 +1,392 @@
+package extension
+
+import (
+	"bytes"
+
+	"github.com/pgavlin/goldmark"
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/extension/ast"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/renderer"
+	"github.com/pgavlin/goldmark/renderer/html"
+	"github.com/pgavlin/goldmark/renderer/markdown"
+	"github.com/pgavlin/goldmark/text"
+	"github.com/pgavlin/goldmark/util"
+)
+
+type inlineMathParser struct {
+}
+
+var defaultInlineMathParser = &inlineMathParser{}
+
+// NewInlineMathParser returns a new InlineParser that parses inline math
+// expressions surrounded by '$', and display math expressions surrounded by
+// '$$'. Like code spans, contents of inline math are not processed for
+// emphasis and backslash escapes.
+//
+// The opening '$' must be followed by a non-space character, and the
+// closing '$' must be preceded by a non-space character and must not be
+// followed by a digit, so that prices like '$5 and $6' are not math.
+func NewInlineMathParser() parser.InlineParser {
+	return defaultInlineMathParser
+}
+
+func (s *inlineMathParser) Trigger() []byte {
+	return []byte{'$'}
+}
+
+func (s *inlineMathParser) CanParseConcurrently() bool {
+	return true
+}
+
+func (s *inlineMathParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	line, startSegment := block.PeekLine()
+	opener := 0
+	for ; opener < len(line) && line[opener] == '$'; opener++ {
+	}
+	if opener > 2 || opener == len(line) || opener == 1 && util.IsSpace(line[opener]) {
+		block.Advance(opener)
+		return gast.NewTextSegment(startSegment.WithStop(startSegment.Start + opener))
+	}
+	block.Advance(opener)
+	l, pos := block.Position()
+	node := ast.NewInlineMath()
+	node.Display = opener == 2
+	blank := true
+	for {
+		line, segment := block.PeekLine()
+		if line == nil {
+			block.SetPosition(l, pos)
+			return gast.NewTextSegment(startSegment.WithStop(startSegment.Start + opener))
+		}
+		for i := 0; i < len(line); i++ {
+			switch line[i] {
+			case '\\':
+				i++
+				blank = false
+			case '$':
+				if !node.Display {
+					if i > 0 && !util.IsSpace(line[i-1]) && (i+1 == len(line) || !util.IsNumeric(line[i+1])) {
+						node.AppendChild(node, gast.NewRawTextSegment(segment.WithStop(segment.Start+i)))
+						block.Advance(i + 1)
+						return node
+					}
+					continue
+				}
+				closer := i
+				for ; closer < len(line) && line[closer] == '$'; closer++ {
+				}
+				if closer-i == 2 && !blank {
+					node.AppendChild(node, gast.NewRawTextSegment(segment.WithStop(segment.Start+i)))
+					block.Advance(closer)
+					return node
+				}
+				i = closer - 1
+				blank = false
+			default:
+				blank = blank && util.IsSpace(line[i])
+			}
+		}
+		node.AppendChild(node, gast.NewRawTextSegment(segment))
+		block.AdvanceLine()
+	}
+}
+
+type displayMathFenceData struct {
+	char   byte
+	indent int
+	length int
+}
+
+var displayMathInfoKey = parser.NewContextKey()
+
+type displayMathParser struct {
+}
+
+var defaultDisplayMathParser = &displayMathParser{}
+
+// NewDisplayMathParser returns a new BlockParser that parses blocks of math
+// fenced with '$$' lines, single lines of math like '$$x$$', and fenced code
+// blocks whose info string is 'math'.
+func NewDisplayMathParser() parser.BlockParser {
+	return defaultDisplayMathParser
+}
+
+func (b *displayMathParser) Trigger() []byte {
+	return []byte{'$', '`', '~'}
+}
+
+func (b *displayMathParser) Open(parent gast.Node, reader text.Reader, pc parser.Context) (gast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 {
+		return nil, parser.NoChildren
+	}
+	fenceChar := line[pos]
+	i := pos
+	for ; i < len(line) && line[i] == fenceChar; i++ {
+	}
+	length := i - pos
+	info := util.TrimRightSpace(util.TrimLeftSpace(line[i:]))
+	switch fenceChar {
+	case '$':
+		if length != 2 {
+			return nil, parser.NoChildren
+		}
+		if len(info) != 0 {
+			return b.openSingleLine(reader, line, segment, pos, info)
+		}
+	case '`', '~':
+		if length < 3 || !bytes.Equal(info, []byte("math")) {
+			return nil, parser.NoChildren
+		}
+	default:
+		return nil, parser.NoChildren
+	}
+	start := segment.Start + pos - segment.Padding
+	node := ast.NewDisplayMath(text.NewSegment(start, segment.Start+len(util.TrimRightSpace(line))-segment.Padding))
+	pc.Set(displayMathInfoKey, &displayMathFenceData{fenceChar, pos, length})
+	reader.AdvanceToEOL()
+	return node, parser.NoChildren
+}
+
+// openSingleLine opens a block of math like '$$x$$' that is closed on the
+// line that opens it.
+func (b *displayMathParser) openSingleLine(
+	reader text.Reader, line []byte, segment text.Segment, pos int, info []byte) (gast.Node, parser.State) {
+	n := len(info) - 2
+	if n <= 0 || info[n] != '$' || info[n+1] != '$' || info[n-1] == '$' || util.IsBlank(info[:n]) {
+		return nil, parser.NoChildren
+	}
+	start := segment.Start + pos - segment.Padding
+	stop := segment.Start + len(util.TrimRightSpace(line)) - segment.Padding
+	node := ast.NewDisplayMath(text.NewSegment(start, start+2))
+	node.Lines().Append(text.NewSegment(start+2, stop-2))
+	node.Closing = text.NewSegment(stop-2, stop)
+	reader.AdvanceToEOL()
+	return node, parser.NoChildren
+}
+
+func (b *displayMathParser) Continue(node gast.Node, reader text.Reader, pc parser.Context) parser.State {
+	if node.(*ast.DisplayMath).HasClosing() {
+		return parser.Close
+	}
+	line, segment := reader.PeekLine()
+	fdata := pc.Get(displayMathInfoKey).(*displayMathFenceData)
+
+	w, pos := util.IndentWidth(line, reader.LineOffset())
+	if w < 4 {
+		i := pos
+		for ; i < len(line) && line[i] == fdata.char; i++ {
+		}
+		length := i - pos
+		if (length == fdata.length || fdata.char != '$' && length > fdata.length) && util.IsBlank(line[i:]) {
+			start := segment.Start + pos - segment.Padding
+			node.(*ast.DisplayMath).Closing = text.NewSegment(start, start+length)
+			reader.AdvanceToEOL()
+			return parser.Close
+		}
+	}
+	pos, padding := util.IndentPositionPadding(line, reader.LineOffset(), segment.Padding, fdata.indent)
+	if pos < 0 {
+		pos = max(0, util.FirstNonSpacePosition(line)) - segment.Padding
+		padding = 0
+	}
+	seg := text.NewSegmentPadding(segment.Start+pos, segment.Stop, padding)
+	seg.ForceNewline = true // EOF as newline
+	node.Lines().Append(seg)
+	reader.AdvanceAndSetPadding(segment.Stop-segment.Start-pos-1, padding)
+	return parser.Continue | parser.NoChildren
+}
+
+func (b *displayMathParser) Close(node gast.Node, reader text.Reader, pc parser.Context) {
+	pc.Set(displayMathInfoKey, nil)
+}
+
+func (b *displayMathParser) CanInterruptParagraph() bool {
+	return true
+}
+
+func (b *displayMathParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+// isSingleLineDisplayMath returns true if n is a block of math like '$$x$$'
+// that is opened and closed on the same line.
+func isSingleLineDisplayMath(n *ast.DisplayMath) bool {
+	lines := n.Lines()
+	return lines.Len() == 1 && lines.At(0).Start == n.Opening.Stop
+}
+
+// MathHTMLRenderer is a renderer.NodeRenderer implementation that renders
+// InlineMath and DisplayMath nodes as markup that KaTeX and MathJax
+// recognize, like '<span class="math inline">\(x\)</span>'.
+type MathHTMLRenderer struct {
+	html.Config
+}
+
+// NewMathHTMLRenderer returns a new MathHTMLRenderer.
+func NewMathHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	r := &MathHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *MathHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindInlineMath, r.renderInlineMath)
+	reg.Register(ast.KindDisplayMath, r.renderDisplayMath)
+}
+
+func (r *MathHTMLRenderer) renderInlineMath(
+	w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	display := n.(*ast.InlineMath).Display
+	if display {
+		_, _ = w.WriteString(`<span class="math display">\[`)
+	} else {
+		_, _ = w.WriteString(`<span class="math inline">\(`)
+	}
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		segment := c.(*gast.Text).Segment
+		value := segment.Value(source)
+		if bytes.HasSuffix(value, []byte("\n")) {
+			r.Writer.RawWrite(w, value[:len(value)-1])
+			r.Writer.RawWrite(w, []byte(" "))
+		} else {
+			r.Writer.RawWrite(w, value)
+		}
+	}
+	if display {
+		_, _ = w.WriteString(`\]</span>`)
+	} else {
+		_, _ = w.WriteString(`\)</span>`)
+	}
+	return gast.WalkSkipChildren, nil
+}
+
+func (r *MathHTMLRenderer) renderDisplayMath(
+	w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	_, _ = w.WriteString("<div class=\"math display\">\\[\n")
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		line := lines.At(i)
+		r.Writer.RawWrite(w, line.Value(source))
+	}
+	if isSingleLineDisplayMath(n.(*ast.DisplayMath)) {
+		_ = w.WriteByte('\n')
+	}
+	_, _ = w.WriteString("\\]</div>\n")
+	return gast.WalkSkipChildren, nil
+}
+
+// MathMarkdownRenderer is a renderer.NodeRenderer implementation that
+// writes InlineMath and DisplayMath nodes back to Markdown using a
+// markdown.Renderer.
+type MathMarkdownRenderer struct {
+	*markdown.Renderer
+}
+
+// NewMathMarkdownRenderer returns a new MathMarkdownRenderer that writes
+// through the given markdown.Renderer.
+func NewMathMarkdownRenderer(r *markdown.Renderer) renderer.NodeRenderer {
+	return &MathMarkdownRenderer{r}
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *MathMarkdownRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindInlineMath, r.renderInlineMath)
+	reg.Register(ast.KindDisplayMath, r.renderDisplayMath)
+}
+
+func (r *MathMarkdownRenderer) renderInlineMath(
+	w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	delimiter := []byte("$")
+	if n.(*ast.InlineMath).Display {
+		delimiter = []byte("$$")
+	}
+	if _, err := r.Write(w, delimiter); err != nil {
+		return gast.WalkStop, err
+	}
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		segment := c.(*gast.Text).Segment
+		if _, err := r.Write(w, segment.Value(source)); err != nil {
+			return gast.WalkStop, err
+		}
+	}
+	if _, err := r.Write(w, delimiter); err != nil {
+		return gast.WalkStop, err
+	}
+	return gast.WalkSkipChildren, nil
+}
+
+func (r *MathMarkdownRenderer) renderDisplayMath(
+	w util.BufWriter, source []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		if err := r.CloseBlock(w); err != nil {
+			return gast.WalkStop, err
+		}
+		return gast.WalkContinue, nil
+	}
+
+	if err := r.OpenBlock(w, source, n); err != nil {
+		return gast.WalkStop, err
+	}
+
+	math := n.(*ast.DisplayMath)
+	if _, err := r.Write(w, math.Opening.Value(source)); err != nil {
+		return gast.WalkStop, err
+	}
+	if !isSingleLineDisplayMath(math) {
+		if err := r.WriteByte(w, '\n'); err != nil {
+			return gast.WalkStop, err
+		}
+	}
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		if _, err := r.Write(w, segment.Value(source)); err != nil {
+			return gast.WalkStop, err
+		}
+	}
+	if math.HasClosing() {
+		if _, err := r.Write(w, math.Closing.Value(source)); err != nil {
+			return gast.WalkStop, err
+		}
+	}
+	return gast.WalkSkipChildren, nil
+}
+
+type mathExtension struct {
+}
+
+// Math is an extension that allows you to use inline math like '$x_1$',
+// display math like '$$x_1$$', and blocks of math fenced with '$$' lines or
+// written as fenced code blocks with the 'math' info string.
+var Math = &mathExtension{}
+
+func (e *mathExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(
+			util.Prioritized(NewDisplayMathParser(), 690),
+		),
+		parser.WithInlineParsers(
+			util.Prioritized(NewInlineMathParser(), 150),
+		),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewMathHTMLRenderer(), 500),
+	))
+}
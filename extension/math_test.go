@@ -0,0 +1,17 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/pgavlin/goldmark"
+	"github.com/pgavlin/goldmark/testutil"
+)
+
+func TestMath(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			Math,
+		),
+	)
+	testutil.DoTestCaseFile(markdown, "_test/math.txt", t, testutil.ParseCliCaseArg()...)
+}
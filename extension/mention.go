@@ -0,0 +1,289 @@
+package extension
+
+import (
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/pgavlin/goldmark"
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/extension/ast"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/renderer"
+	"github.com/pgavlin/goldmark/renderer/html"
+	"github.com/pgavlin/goldmark/renderer/markdown"
+	"github.com/pgavlin/goldmark/text"
+	"github.com/pgavlin/goldmark/util"
+)
+
+var repositoryIssueRegexp = regexp.MustCompile(`^(?P<repository>[A-Za-z0-9][-A-Za-z0-9_.]*/[-A-Za-z0-9_.]+)#(?P<id>[0-9]+)`)
+
+var issueRegexp = regexp.MustCompile(`^#(?P<id>[0-9]+)`)
+
+var userRegexp = regexp.MustCompile(`^@(?P<id>[A-Za-z0-9](?:-?[A-Za-z0-9])*(?:/[A-Za-z0-9][-A-Za-z0-9_]*)?)`)
+
+var hashtagRegexp = regexp.MustCompile(`^#(?P<id>\pL[-\pL\pN_]*)`)
+
+// A MentionPattern struct is a pattern of mentions.
+//
+// Regexp should start with '^'. If Regexp has a group named 'id', the group
+// is the ID of the mention; otherwise the first group is, or the whole
+// match if Regexp has no groups. If Regexp has a group named 'repository',
+// the group is the repository of the mention.
+//
+// For example, Jira issue keys like 'PROJ-123' can be matched with
+//
+//	MentionPattern{
+//		Type:   ast.MentionIssue,
+//		Regexp: regexp.MustCompile(`^(?P<repository>[A-Z][A-Z0-9]+)-(?P<id>[0-9]+)`),
+//	}
+type MentionPattern struct {
+	// Type is a type of mentions that match this pattern.
+	Type ast.MentionType
+
+	// Regexp is a regular expression that matches mentions.
+	Regexp *regexp.Regexp
+}
+
+// DefaultMentionPatterns returns patterns of GitHub style mentions:
+// issues like 'org/repo#45' and '#123', users like '@user' and hashtags
+// like '#tag'.
+func DefaultMentionPatterns() []MentionPattern {
+	return []MentionPattern{
+		{Type: ast.MentionIssue, Regexp: repositoryIssueRegexp},
+		{Type: ast.MentionIssue, Regexp: issueRegexp},
+		{Type: ast.MentionUser, Regexp: userRegexp},
+		{Type: ast.MentionHashtag, Regexp: hashtagRegexp},
+	}
+}
+
+// A MentionResolver interface resolves mentions to destinations.
+//
+// The parser of mentions can parse concurrently (see
+// parser.WithParallelInlines), so ResolveMention may be called concurrently
+// and must be safe for concurrent use.
+type MentionResolver interface {
+	// ResolveMention returns a destination of the given mention, and
+	// whether the mention was resolved. ResolveMention may set
+	// attributes of the mention, like a class, which are rendered on the
+	// link.
+	ResolveMention(mention *ast.Mention) (destination []byte, ok bool)
+}
+
+// MentionResolverFunc is a function that implements MentionResolver.
+type MentionResolverFunc func(mention *ast.Mention) ([]byte, bool)
+
+// ResolveMention implements MentionResolver.ResolveMention.
+func (f MentionResolverFunc) ResolveMention(mention *ast.Mention) ([]byte, bool) {
+	return f(mention)
+}
+
+// MentionConfig struct holds options for the extension.
+type MentionConfig struct {
+	// Patterns are patterns of mentions, in order of precedence.
+	Patterns []MentionPattern
+
+	// Resolver resolves mentions. If Resolver is nil, no mentions are
+	// resolved, so all of them are rendered as plain text.
+	Resolver MentionResolver
+}
+
+// NewMentionConfig returns a new MentionConfig with defaults.
+func NewMentionConfig() MentionConfig {
+	return MentionConfig{
+		Patterns: DefaultMentionPatterns(),
+	}
+}
+
+// A MentionOption sets options for mentions.
+type MentionOption func(*MentionConfig)
+
+// WithMentionPatterns is a functional option that replaces the patterns of
+// mentions. Append to DefaultMentionPatterns to keep the defaults.
+func WithMentionPatterns(patterns ...MentionPattern) MentionOption {
+	return func(c *MentionConfig) {
+		c.Patterns = patterns
+	}
+}
+
+// WithMentionResolver is a functional option that sets a MentionResolver.
+func WithMentionResolver(resolver MentionResolver) MentionOption {
+	return func(c *MentionConfig) {
+		c.Resolver = resolver
+	}
+}
+
+type mentionParser struct {
+	MentionConfig
+}
+
+// NewMentionParser returns a new InlineParser that parses mentions like
+// '@user', '#123', 'org/repo#45' and '#tag'. Mentions must start and end
+// at word boundaries.
+func NewMentionParser(opts ...MentionOption) parser.InlineParser {
+	p := &mentionParser{
+		MentionConfig: NewMentionConfig(),
+	}
+	for _, o := range opts {
+		o(&p.MentionConfig)
+	}
+	return p
+}
+
+func (s *mentionParser) Trigger() []byte {
+	// ' ' indicates any white spaces and a line head
+	return []byte{' ', '(', '@', '#'}
+}
+
+func (s *mentionParser) CanParseConcurrently() bool {
+	return true
+}
+
+func (s *mentionParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	if pc.IsInLinkLabel() {
+		return nil
+	}
+	line, segment := block.PeekLine()
+	consumes := 0
+	c := line[0]
+	if util.IsSpace(c) || c == '(' {
+		// advance if current position is not a line head.
+		consumes++
+	} else if (c == '@' || c == '#') && isWordRune(block.PrecendingCharacter()) {
+		return nil
+	} else if c == '#' && block.PrecendingCharacter() == '&' {
+		// '#' starts a numeric character reference like '&#169;'.
+		return nil
+	}
+	line = line[consumes:]
+
+	for _, pattern := range s.Patterns {
+		m := pattern.Regexp.FindSubmatchIndex(line)
+		if m == nil || m[0] != 0 || m[1] == 0 {
+			continue
+		}
+		if r, _ := utf8.DecodeRune(line[m[1]:]); m[1] < len(line) && isWordRune(r) {
+			continue
+		}
+
+		id := line[:m[1]]
+		if i := pattern.Regexp.SubexpIndex("id"); i > 0 && m[2*i] >= 0 {
+			id = line[m[2*i]:m[2*i+1]]
+		} else if pattern.Regexp.NumSubexp() > 0 && m[2] >= 0 {
+			id = line[m[2]:m[3]]
+		}
+		var repository []byte
+		if i := pattern.Regexp.SubexpIndex("repository"); i > 0 && m[2*i] >= 0 {
+			repository = append([]byte{}, line[m[2*i]:m[2*i+1]]...)
+		}
+
+		if consumes != 0 {
+			gast.MergeOrAppendTextSegment(parent, segment.WithStop(segment.Start+consumes))
+		}
+		start := segment.Start + consumes
+		node := ast.NewMention(pattern.Type, repository, append([]byte{}, id...))
+		node.AppendChild(node, gast.NewTextSegment(text.NewSegment(start, start+m[1])))
+		if s.Resolver != nil {
+			node.Destination, node.Resolved = s.Resolver.ResolveMention(node)
+		}
+		block.Advance(consumes + m[1])
+		return node
+	}
+	return nil
+}
+
+// MentionHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders resolved Mention nodes as links, and unresolved Mention nodes
+// as plain text.
+type MentionHTMLRenderer struct {
+	html.Config
+}
+
+// NewMentionHTMLRenderer returns a new MentionHTMLRenderer.
+func NewMentionHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	r := &MentionHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *MentionHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindMention, r.renderMention)
+}
+
+func (r *MentionHTMLRenderer) renderMention(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	n := node.(*ast.Mention)
+	if !n.Resolved {
+		return gast.WalkContinue, nil
+	}
+	if !entering {
+		_, _ = w.WriteString("</a>")
+		return gast.WalkContinue, nil
+	}
+	_, _ = w.WriteString(`<a href="`)
+	if r.Unsafe || !html.IsDangerousURL(n.Destination) {
+		_, _ = w.Write(util.EscapeHTML(util.URLEscape(n.Destination, false)))
+	}
+	_ = w.WriteByte('"')
+	if n.Attributes() != nil {
+		html.RenderAttributes(w, n, html.LinkAttributeFilter)
+	}
+	_ = w.WriteByte('>')
+	return gast.WalkContinue, nil
+}
+
+// MentionMarkdownRenderer is a renderer.NodeRenderer implementation that
+// writes Mention nodes back to Markdown using a markdown.Renderer.
+type MentionMarkdownRenderer struct {
+	*markdown.Renderer
+}
+
+// NewMentionMarkdownRenderer returns a new MentionMarkdownRenderer that
+// writes through the given markdown.Renderer.
+func NewMentionMarkdownRenderer(r *markdown.Renderer) renderer.NodeRenderer {
+	return &MentionMarkdownRenderer{r}
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *MentionMarkdownRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindMention, r.renderMention)
+}
+
+func (r *MentionMarkdownRenderer) renderMention(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	// A mention is written as it was in the source, whether it was resolved
+	// or not, so only its text child is written.
+	return gast.WalkContinue, nil
+}
+
+type mention struct {
+	options []MentionOption
+}
+
+// Mention is an extension that allows you to use mentions like
+// '@user', '#123', 'org/repo#45' and '#tag'.
+//
+// Mention has no MentionResolver, so it parses mentions but renders all of
+// them as plain text. Use NewMention with WithMentionResolver to render
+// mentions as links.
+var Mention = &mention{}
+
+// NewMention returns a new extension with given options.
+func NewMention(opts ...MentionOption) goldmark.Extender {
+	return &mention{
+		options: opts,
+	}
+}
+
+func (e *mention) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(NewMentionParser(e.options...), 1000),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewMentionHTMLRenderer(), 500),
+	))
+}
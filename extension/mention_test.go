@@ -0,0 +1,85 @@
+package extension
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+
+	"github.com/pgavlin/goldmark"
+	"github.com/pgavlin/goldmark/extension/ast"
+	"github.com/pgavlin/goldmark/testutil"
+	"github.com/pgavlin/goldmark/text"
+)
+
+var testMentionResolver = MentionResolverFunc(func(mention *ast.Mention) ([]byte, bool) {
+	id := string(mention.ID)
+	switch mention.MentionType {
+	case ast.MentionUser:
+		if id == "nobody" {
+			return nil, false
+		}
+		return []byte("/users/" + id), true
+	case ast.MentionIssue:
+		if mention.Repository != nil {
+			return []byte("/" + string(mention.Repository) + "/issues/" + id), true
+		}
+		return []byte("/issues/" + id), true
+	case ast.MentionHashtag:
+		if id == "unknown" {
+			return nil, false
+		}
+		return []byte("/tags/" + id), true
+	}
+	return nil, false
+})
+
+func TestMentions(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			NewMention(WithMentionResolver(testMentionResolver)),
+		),
+	)
+	testutil.DoTestCaseFile(markdown, "_test/mention.txt", t, testutil.ParseCliCaseArg()...)
+}
+
+func TestMentionsWithoutResolver(t *testing.T) {
+	md := goldmark.New(goldmark.WithExtensions(Mention))
+	source := []byte("@alice fixed #123")
+	doc := md.Parser().Parse(text.NewReader(source))
+	if n, ok := doc.FirstChild().FirstChild().(*ast.Mention); !ok || string(n.ID) != "alice" || n.Resolved {
+		t.Errorf("expected an unresolved mention, got %#v", doc.FirstChild().FirstChild())
+	}
+	var buf bytes.Buffer
+	if err := md.Convert(source, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "<p>@alice fixed #123</p>\n"; buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestMentionPatterns(t *testing.T) {
+	jira := MentionPattern{
+		Type:   ast.MentionIssue,
+		Regexp: regexp.MustCompile(`^(?P<repository>[A-Z][A-Z0-9]+)-(?P<id>[0-9]+)`),
+	}
+	resolver := MentionResolverFunc(func(mention *ast.Mention) ([]byte, bool) {
+		if mention.MentionType != ast.MentionIssue {
+			return nil, false
+		}
+		mention.SetAttributeString("class", []byte("issue"))
+		return []byte("https://jira.example.com/browse/" + string(mention.Repository) + "-" + string(mention.ID)), true
+	})
+	md := goldmark.New(goldmark.WithExtensions(NewMention(
+		WithMentionPatterns(append(DefaultMentionPatterns(), jira)...),
+		WithMentionResolver(resolver),
+	)))
+	var buf bytes.Buffer
+	if err := md.Convert([]byte("PROJ-123 fixes XPROJ-1a and @alice\n"), &buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := `<p><a href="https://jira.example.com/browse/PROJ-123" class="issue">PROJ-123</a> fixes XPROJ-1a and @alice</p>` + "\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
@@ -43,6 +43,10 @@ func (s *strikethroughParser) Trigger() []byte {
 	return []byte{'~'}
 }
 
+func (s *strikethroughParser) CanParseConcurrently() bool {
+	return true
+}
+
 func (s *strikethroughParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
 	before := block.PrecendingCharacter()
 	line, segment := block.PeekLine()
@@ -293,6 +293,10 @@ func NewTableASTTransformer() parser.ASTTransformer {
 	return defaultTableASTTransformer
 }
 
+func (a *tableASTTransformer) CanTransformLazily() bool {
+	return true
+}
+
 func (a *tableASTTransformer) Transform(node *gast.Document, reader text.Reader, pc parser.Context) {
 	lst := pc.Get(escapedPipeCellListKey)
 	if lst == nil {
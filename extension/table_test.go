@@ -1,6 +1,7 @@
 package extension
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/pgavlin/goldmark"
@@ -392,3 +393,24 @@ func TestTableFuzzedPanics(t *testing.T) {
 		t,
 	)
 }
+
+func TestTableLazyInlines(t *testing.T) {
+	markdown := goldmark.New(goldmark.WithExtensions(NewTable()))
+	source := []byte("| `a\\|b` |\n| - |\n\n*c*\n")
+	pc := parser.NewContext()
+	doc := markdown.Parser().Parse(text.NewReader(source), parser.WithLazyInlines(), parser.WithContext(pc))
+	if p := doc.LastChild().(*ast.Paragraph); !p.InlinesDeferred() {
+		t.Error("expected inlines to be parsed lazily")
+	}
+	if diagnostics := parser.Diagnostics(pc); len(diagnostics) != 0 {
+		t.Errorf("expected no diagnostics, got %v", diagnostics)
+	}
+	var buf bytes.Buffer
+	if err := markdown.Renderer().Render(&buf, source, doc); err != nil {
+		t.Fatal(err)
+	}
+	expected := "<table>\n<thead>\n<tr>\n<th><code>a|b</code></th>\n</tr>\n</thead>\n</table>\n<p><em>c</em></p>\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
@@ -0,0 +1,141 @@
+package extension
+
+import (
+	"bytes"
+
+	"github.com/pgavlin/goldmark"
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/renderer"
+	"github.com/pgavlin/goldmark/renderer/html"
+	"github.com/pgavlin/goldmark/util"
+)
+
+// DisallowedRawHTMLTags is a list of tag names that are filtered by the
+// TagFilter extension.
+var DisallowedRawHTMLTags = []string{
+	"title",
+	"textarea",
+	"style",
+	"xmp",
+	"iframe",
+	"noembed",
+	"noframes",
+	"script",
+	"plaintext",
+}
+
+// TagFilterHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders RawHTML and HTMLBlock nodes with disallowed tags escaped.
+type TagFilterHTMLRenderer struct {
+	html.Config
+}
+
+// NewTagFilterHTMLRenderer returns a new TagFilterHTMLRenderer.
+func NewTagFilterHTMLRenderer(opts ...html.Option) renderer.NodeRenderer {
+	r := &TagFilterHTMLRenderer{
+		Config: html.NewConfig(),
+	}
+	for _, opt := range opts {
+		opt.SetHTMLOption(&r.Config)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *TagFilterHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(gast.KindHTMLBlock, r.renderHTMLBlock)
+	reg.Register(gast.KindRawHTML, r.renderRawHTML)
+}
+
+func (r *TagFilterHTMLRenderer) renderHTMLBlock(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	n := node.(*gast.HTMLBlock)
+	if entering {
+		if r.Unsafe {
+			l := n.Lines().Len()
+			for i := 0; i < l; i++ {
+				line := n.Lines().At(i)
+				writeTagFiltered(w, line.Value(source), r.Writer.SecureWrite)
+			}
+		} else {
+			_, _ = w.WriteString("<!-- raw HTML omitted -->\n")
+		}
+	} else {
+		if n.HasClosure() {
+			if r.Unsafe {
+				closure := n.ClosureLine
+				writeTagFiltered(w, closure.Value(source), r.Writer.SecureWrite)
+			} else {
+				_, _ = w.WriteString("<!-- raw HTML omitted -->\n")
+			}
+		}
+	}
+	return gast.WalkContinue, nil
+}
+
+func (r *TagFilterHTMLRenderer) renderRawHTML(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkSkipChildren, nil
+	}
+	if r.Unsafe {
+		n := node.(*gast.RawHTML)
+		l := n.Segments.Len()
+		for i := 0; i < l; i++ {
+			segment := n.Segments.At(i)
+			writeTagFiltered(w, segment.Value(source), func(w util.BufWriter, b []byte) {
+				_, _ = w.Write(b)
+			})
+		}
+		return gast.WalkSkipChildren, nil
+	}
+	_, _ = w.WriteString("<!-- raw HTML omitted -->")
+	return gast.WalkSkipChildren, nil
+}
+
+// writeTagFiltered writes the given HTML with the leading '<' of disallowed
+// tags replaced with '&lt;'.
+func writeTagFiltered(w util.BufWriter, value []byte,
+	write func(w util.BufWriter, b []byte)) {
+	start := 0
+	for i := 0; i < len(value); i++ {
+		if value[i] == '<' && isDisallowedTag(value[i+1:]) {
+			write(w, value[start:i])
+			_, _ = w.WriteString("&lt;")
+			start = i + 1
+		}
+	}
+	write(w, value[start:])
+}
+
+// isDisallowedTag returns true if the given value (that follows a '<')
+// starts with an opening or closing tag listed in DisallowedRawHTMLTags.
+func isDisallowedTag(value []byte) bool {
+	if len(value) != 0 && value[0] == '/' {
+		value = value[1:]
+	}
+	for _, tag := range DisallowedRawHTMLTags {
+		if len(value) <= len(tag) || !bytes.EqualFold(value[:len(tag)], []byte(tag)) {
+			continue
+		}
+		rest := value[len(tag):]
+		if util.IsSpace(rest[0]) || rest[0] == '>' ||
+			(rest[0] == '/' && len(rest) > 1 && rest[1] == '>') {
+			return true
+		}
+	}
+	return false
+}
+
+type tagFilter struct {
+}
+
+// TagFilter is an extension that escapes the raw HTML tags disallowed by
+// GitHub Flavored Markdown, like '<script>' and '<iframe>'.
+var TagFilter = &tagFilter{}
+
+func (e *tagFilter) Extend(m goldmark.Markdown) {
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewTagFilterHTMLRenderer(), 500),
+	))
+}
@@ -0,0 +1,42 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/pgavlin/goldmark"
+	"github.com/pgavlin/goldmark/renderer/html"
+	"github.com/pgavlin/goldmark/testutil"
+)
+
+func TestTagFilter(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithRendererOptions(
+			html.WithUnsafe(),
+		),
+		goldmark.WithExtensions(
+			TagFilter,
+		),
+	)
+	testutil.DoTestCaseFile(markdown, "_test/tagfilter.txt", t, testutil.ParseCliCaseArg()...)
+}
+
+func TestTagFilterSafe(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			GFM,
+		),
+	)
+	testutil.DoTestCase(
+		markdown,
+		testutil.MarkdownTestCase{
+			No:          1,
+			Description: "Raw HTML is omitted without html.WithUnsafe",
+			Markdown: `<script>alert(1)</script>
+
+a <title>`,
+			Expected: `<!-- raw HTML omitted -->
+<p>a <!-- raw HTML omitted --></p>`,
+		},
+		t,
+	)
+}
@@ -31,6 +31,10 @@ func (s *taskCheckBoxParser) Trigger() []byte {
 	return []byte{'['}
 }
 
+func (s *taskCheckBoxParser) CanParseConcurrently() bool {
+	return true
+}
+
 func (s *taskCheckBoxParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
 	// Given AST structure must be like
 	// - List
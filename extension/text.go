@@ -0,0 +1,9 @@
+package extension
+
+import "unicode"
+
+// isWordRune returns true if the given rune can be a part of a word, i.e.
+// it is a letter, a digit or an underscore.
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -163,6 +163,12 @@ func (s *typographerParser) Trigger() []byte {
 	return []byte{'\'', '"', '-', '.', ',', '<', '>', '*', '['}
 }
 
+// CanParseConcurrently returns false, because quotes that are not closed in
+// a block affect the quotes of the following blocks.
+func (s *typographerParser) CanParseConcurrently() bool {
+	return false
+}
+
 func (s *typographerParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
 	line, _ := block.PeekLine()
 	c := line[0]
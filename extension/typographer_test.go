@@ -1,11 +1,14 @@
 package extension
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/pgavlin/goldmark"
+	"github.com/pgavlin/goldmark/parser"
 	"github.com/pgavlin/goldmark/renderer/html"
 	"github.com/pgavlin/goldmark/testutil"
+	"github.com/pgavlin/goldmark/text"
 )
 
 func TestTypographer(t *testing.T) {
@@ -19,3 +22,21 @@ func TestTypographer(t *testing.T) {
 	)
 	testutil.DoTestCaseFile(markdown, "_test/typographer.txt", t, testutil.ParseCliCaseArg()...)
 }
+
+func TestTypographerParallelInlines(t *testing.T) {
+	markdown := goldmark.New(goldmark.WithExtensions(Typographer))
+	// Quotes that are not closed in a paragraph affect the following ones.
+	source := []byte("He said \"hello\n\nand 'bye\" there\n\n\"quoted\" 'text'\n")
+	var serial, parallel bytes.Buffer
+	doc := markdown.Parser().Parse(text.NewReader(source))
+	if err := markdown.Renderer().Render(&serial, source, doc); err != nil {
+		t.Fatal(err)
+	}
+	doc = markdown.Parser().Parse(text.NewReader(source), parser.WithParallelInlines(2))
+	if err := markdown.Renderer().Render(&parallel, source, doc); err != nil {
+		t.Fatal(err)
+	}
+	if serial.String() != parallel.String() {
+		t.Errorf("expected %q, got %q", serial.String(), parallel.String())
+	}
+}
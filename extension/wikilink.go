@@ -0,0 +1,369 @@
+package extension
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/pgavlin/goldmark"
+	gast "github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/extension/ast"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pgavlin/goldmark/renderer"
+	"github.com/pgavlin/goldmark/renderer/html"
+	"github.com/pgavlin/goldmark/renderer/markdown"
+	"github.com/pgavlin/goldmark/text"
+	"github.com/pgavlin/goldmark/util"
+)
+
+// A WikiLinkResolver interface maps targets of wiki links to destinations.
+//
+// The parser of wiki links can parse concurrently (see
+// parser.WithParallelInlines), so ResolveWikiLink may be called concurrently
+// and must be safe for concurrent use.
+type WikiLinkResolver interface {
+	// ResolveWikiLink returns a destination of the page with the given name,
+	// and whether the page exists.
+	ResolveWikiLink(target []byte) (destination []byte, exists bool)
+}
+
+// WikiLinkResolverFunc is a function that implements WikiLinkResolver.
+type WikiLinkResolverFunc func(target []byte) ([]byte, bool)
+
+// ResolveWikiLink implements WikiLinkResolver.ResolveWikiLink.
+func (f WikiLinkResolverFunc) ResolveWikiLink(target []byte) ([]byte, bool) {
+	return f(target)
+}
+
+// DefaultWikiLinkResolver is a WikiLinkResolver that uses targets as
+// destinations as they are. All pages exist.
+var DefaultWikiLinkResolver WikiLinkResolver = WikiLinkResolverFunc(func(target []byte) ([]byte, bool) {
+	return target, true
+})
+
+// WikiLinkConfig struct holds options for the extension.
+type WikiLinkConfig struct {
+	// Resolver maps targets of wiki links to destinations.
+	Resolver WikiLinkResolver
+
+	// Embeds indicates whether embeds like '![[image.png]]' are parsed.
+	Embeds bool
+
+	// MissingClass is a class of links to pages that do not exist.
+	MissingClass string
+}
+
+// NewWikiLinkConfig returns a new WikiLinkConfig with defaults.
+func NewWikiLinkConfig() WikiLinkConfig {
+	return WikiLinkConfig{
+		Resolver:     DefaultWikiLinkResolver,
+		MissingClass: "missing",
+	}
+}
+
+// A WikiLinkOption sets options for wiki links.
+type WikiLinkOption func(*WikiLinkConfig)
+
+// WithWikiLinkResolver is a functional option that sets a WikiLinkResolver.
+func WithWikiLinkResolver(resolver WikiLinkResolver) WikiLinkOption {
+	return func(c *WikiLinkConfig) {
+		c.Resolver = resolver
+	}
+}
+
+// WithWikiLinkEmbeds is a functional option that enables embeds like
+// '![[image.png]]', which are rendered as images.
+func WithWikiLinkEmbeds() WikiLinkOption {
+	return func(c *WikiLinkConfig) {
+		c.Embeds = true
+	}
+}
+
+// WithWikiLinkMissingClass is a functional option that sets a class of links
+// to pages that do not exist. The default is 'missing'.
+func WithWikiLinkMissingClass(class string) WikiLinkOption {
+	return func(c *WikiLinkConfig) {
+		c.MissingClass = class
+	}
+}
+
+type wikiLinkParser struct {
+	WikiLinkConfig
+}
+
+// NewWikiLinkParser returns a new InlineParser that parses wiki links like
+// '[[Page]]', '[[Page|label]]' and '[[Page#Section]]'. A wiki link must fit
+// on one line and must not contain brackets.
+func NewWikiLinkParser(opts ...WikiLinkOption) parser.InlineParser {
+	p := &wikiLinkParser{
+		WikiLinkConfig: NewWikiLinkConfig(),
+	}
+	for _, o := range opts {
+		o(&p.WikiLinkConfig)
+	}
+	return p
+}
+
+func (s *wikiLinkParser) Trigger() []byte {
+	return []byte{'!', '['}
+}
+
+func (s *wikiLinkParser) CanParseConcurrently() bool {
+	return true
+}
+
+func (s *wikiLinkParser) Parse(parent gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	line, segment := block.PeekLine()
+	start := 2
+	embed := line[0] == '!'
+	if embed {
+		start++
+	}
+	if len(line) < start || !bytes.HasPrefix(line[start-2:], []byte("[[")) {
+		return nil
+	}
+	stop := start
+	for ; stop < len(line) && line[stop] != ']' && line[stop] != '[' && line[stop] != '\n'; stop++ {
+	}
+	if stop+1 >= len(line) || line[stop] != ']' || line[stop+1] != ']' {
+		return nil
+	}
+	inner := line[start:stop]
+	label := -1
+	if i := bytes.IndexByte(inner, '|'); i >= 0 {
+		label = i
+		inner = inner[:i]
+	}
+	target, fragment := inner, []byte(nil)
+	if i := bytes.IndexByte(inner, '#'); i >= 0 {
+		target, fragment = inner[:i], util.TrimRightSpace(util.TrimLeftSpace(inner[i+1:]))
+	}
+	target = util.TrimRightSpace(util.TrimLeftSpace(target))
+	if len(target) == 0 && len(fragment) == 0 {
+		return nil
+	}
+
+	if embed && !s.Embeds {
+		// Leave the '!' as text so that the image parser does not consume
+		// the opening bracket of the wiki link.
+		block.Advance(1)
+		return gast.NewTextSegment(text.NewSegment(segment.Start, segment.Start+1))
+	}
+
+	node := ast.NewWikiLink(append([]byte{}, target...), append([]byte{}, fragment...))
+	node.Embed = embed
+	display := text.NewSegment(segment.Start+start, segment.Start+stop)
+	if label >= 0 {
+		display = display.WithStart(display.Start + label + 1)
+		node.Label = append([]byte{}, block.Value(display)...)
+	} else {
+		display = display.WithStop(display.Start + len(inner))
+	}
+	display = display.TrimLeftSpace(block.Source())
+	display = display.TrimRightSpace(block.Source())
+	if !display.IsEmpty() {
+		node.AppendChild(node, gast.NewTextSegment(display))
+	}
+	if len(target) != 0 {
+		node.Destination, node.Exists = s.Resolver.ResolveWikiLink(node.Target)
+	} else {
+		node.Exists = true
+	}
+	block.Advance(stop + 2)
+	return node
+}
+
+// WikiLinks returns the wiki links in the given document in document order.
+func WikiLinks(doc gast.Node) []*ast.WikiLink {
+	var links []*ast.WikiLink
+	_ = gast.Walk(doc, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if link, ok := n.(*ast.WikiLink); ok && entering {
+			links = append(links, link)
+		}
+		return gast.WalkContinue, nil
+	})
+	return links
+}
+
+// A WikiLinkGraph is a graph of pages linked by wiki links. Links to sections
+// of the same page are ignored.
+type WikiLinkGraph struct {
+	links map[string][]string
+}
+
+// NewWikiLinkGraph returns a new empty WikiLinkGraph.
+func NewWikiLinkGraph() *WikiLinkGraph {
+	return &WikiLinkGraph{
+		links: map[string][]string{},
+	}
+}
+
+// AddDocument adds the wiki links in the given document of the given page to
+// this graph. Links that were previously added for the page are replaced.
+func (g *WikiLinkGraph) AddDocument(page string, doc gast.Node) {
+	var targets []string
+	seen := map[string]bool{}
+	for _, link := range WikiLinks(doc) {
+		target := string(link.Target)
+		if len(target) != 0 && !seen[target] {
+			seen[target] = true
+			targets = append(targets, target)
+		}
+	}
+	g.links[page] = targets
+}
+
+// Links returns the targets of the wiki links of the given page in order of
+// their first appearance.
+func (g *WikiLinkGraph) Links(page string) []string {
+	return g.links[page]
+}
+
+// Backlinks returns the sorted names of pages that link to the given page.
+func (g *WikiLinkGraph) Backlinks(page string) []string {
+	var pages []string
+	for p, targets := range g.links {
+		for _, target := range targets {
+			if target == page {
+				pages = append(pages, p)
+				break
+			}
+		}
+	}
+	sort.Strings(pages)
+	return pages
+}
+
+// WikiLinkHTMLRenderer is a renderer.NodeRenderer implementation that
+// renders WikiLink nodes as links, and embeds as images.
+type WikiLinkHTMLRenderer struct {
+	html.Config
+	WikiLinkConfig
+}
+
+// NewWikiLinkHTMLRenderer returns a new WikiLinkHTMLRenderer.
+func NewWikiLinkHTMLRenderer(opts ...WikiLinkOption) renderer.NodeRenderer {
+	r := &WikiLinkHTMLRenderer{
+		Config:         html.NewConfig(),
+		WikiLinkConfig: NewWikiLinkConfig(),
+	}
+	for _, o := range opts {
+		o(&r.WikiLinkConfig)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *WikiLinkHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindWikiLink, r.renderWikiLink)
+}
+
+func (r *WikiLinkHTMLRenderer) renderWikiLink(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	n := node.(*ast.WikiLink)
+	if !entering {
+		if !n.Embed {
+			_, _ = w.WriteString("</a>")
+		}
+		return gast.WalkContinue, nil
+	}
+
+	destination := n.Destination
+	if len(n.Fragment) != 0 {
+		destination = append(append(append([]byte{}, destination...), '#'), n.Fragment...)
+	}
+	if n.Embed {
+		_, _ = w.WriteString(`<img src="`)
+	} else {
+		_, _ = w.WriteString(`<a href="`)
+	}
+	if r.Unsafe || !html.IsDangerousURL(destination) {
+		_, _ = w.Write(util.EscapeHTML(util.URLEscape(destination, true)))
+	}
+	_ = w.WriteByte('"')
+	if !n.Exists && len(r.MissingClass) != 0 {
+		_, _ = w.WriteString(` class="`)
+		_, _ = w.Write(util.EscapeHTML([]byte(r.MissingClass)))
+		_ = w.WriteByte('"')
+	}
+	if !n.Embed {
+		_ = w.WriteByte('>')
+		return gast.WalkContinue, nil
+	}
+	_, _ = w.WriteString(` alt="`)
+	_, _ = w.Write(util.EscapeHTML(n.Text(source)))
+	_ = w.WriteByte('"')
+	if r.XHTML {
+		_, _ = w.WriteString(" />")
+	} else {
+		_, _ = w.WriteString(">")
+	}
+	return gast.WalkSkipChildren, nil
+}
+
+// WikiLinkMarkdownRenderer is a renderer.NodeRenderer implementation that
+// writes WikiLink nodes back to Markdown using a markdown.Renderer.
+type WikiLinkMarkdownRenderer struct {
+	*markdown.Renderer
+}
+
+// NewWikiLinkMarkdownRenderer returns a new WikiLinkMarkdownRenderer that
+// writes through the given markdown.Renderer.
+func NewWikiLinkMarkdownRenderer(r *markdown.Renderer) renderer.NodeRenderer {
+	return &WikiLinkMarkdownRenderer{r}
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *WikiLinkMarkdownRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindWikiLink, r.renderWikiLink)
+}
+
+func (r *WikiLinkMarkdownRenderer) renderWikiLink(
+	w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	n := node.(*ast.WikiLink)
+	var buf bytes.Buffer
+	if n.Embed {
+		buf.WriteByte('!')
+	}
+	buf.WriteString("[[")
+	buf.Write(n.Target)
+	if len(n.Fragment) != 0 {
+		buf.WriteByte('#')
+		buf.Write(n.Fragment)
+	}
+	if n.Label != nil {
+		buf.WriteByte('|')
+		buf.Write(n.Label)
+	}
+	buf.WriteString("]]")
+	if _, err := r.Write(w, buf.Bytes()); err != nil {
+		return gast.WalkStop, err
+	}
+	return gast.WalkSkipChildren, nil
+}
+
+type wikiLinks struct {
+	options []WikiLinkOption
+}
+
+// WikiLink is an extension that allows you to use wiki links like
+// '[[Page Name]]', '[[Page Name|label]]' and '[[Page#Section]]'.
+var WikiLink = &wikiLinks{}
+
+// NewWikiLink returns a new extension with given options.
+func NewWikiLink(opts ...WikiLinkOption) goldmark.Extender {
+	return &wikiLinks{
+		options: opts,
+	}
+}
+
+func (e *wikiLinks) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(NewWikiLinkParser(e.options...), 199),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewWikiLinkHTMLRenderer(e.options...), 500),
+	))
+}
@@ -0,0 +1,87 @@
+package extension
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/pgavlin/goldmark"
+	"github.com/pgavlin/goldmark/testutil"
+	"github.com/pgavlin/goldmark/text"
+)
+
+func TestWikiLink(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			WikiLink,
+		),
+	)
+	testutil.DoTestCaseFile(markdown, "_test/wikilink.txt", t, testutil.ParseCliCaseArg()...)
+}
+
+func TestWikiLinkOptions(t *testing.T) {
+	pages := map[string]string{"Home": "/wiki/home", "logo.png": "/media/logo.png"}
+	resolver := WikiLinkResolverFunc(func(target []byte) ([]byte, bool) {
+		if dest, ok := pages[string(target)]; ok {
+			return []byte(dest), true
+		}
+		return append([]byte("/wiki/new?page="), target...), false
+	})
+	cases := []struct {
+		options  []WikiLinkOption
+		source   string
+		expected string
+	}{
+		{
+			[]WikiLinkOption{WithWikiLinkResolver(resolver)},
+			"[[Home#Intro|home]] [[Nowhere]]",
+			`<p><a href="/wiki/home#Intro">home</a> <a href="/wiki/new?page=Nowhere" class="missing">Nowhere</a></p>` + "\n",
+		},
+		{
+			[]WikiLinkOption{WithWikiLinkResolver(resolver), WithWikiLinkMissingClass("new")},
+			"[[Nowhere]]",
+			`<p><a href="/wiki/new?page=Nowhere" class="new">Nowhere</a></p>` + "\n",
+		},
+		{
+			[]WikiLinkOption{WithWikiLinkResolver(resolver), WithWikiLinkEmbeds()},
+			"![[logo.png|Logo]] [[Home]]",
+			`<p><img src="/media/logo.png" alt="Logo"> <a href="/wiki/home">Home</a></p>` + "\n",
+		},
+	}
+	for _, c := range cases {
+		md := goldmark.New(goldmark.WithExtensions(NewWikiLink(c.options...)))
+		var buf bytes.Buffer
+		if err := md.Convert([]byte(c.source), &buf); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != c.expected {
+			t.Errorf("expected %q, got %q", c.expected, buf.String())
+		}
+	}
+}
+
+func TestWikiLinkGraph(t *testing.T) {
+	md := goldmark.New(goldmark.WithExtensions(WikiLink))
+	pages := map[string]string{
+		"Home":  "[[Guide]], [[FAQ#Install]] and [[Guide|the guide]], [[#Top]]",
+		"Guide": "- [[Home]]\n- [[FAQ]]",
+		"FAQ":   "Nothing here.",
+	}
+	graph := NewWikiLinkGraph()
+	for page, source := range pages {
+		graph.AddDocument(page, md.Parser().Parse(text.NewReader([]byte(source))))
+	}
+
+	if links := graph.Links("Home"); !reflect.DeepEqual(links, []string{"Guide", "FAQ"}) {
+		t.Errorf("unexpected links: %v", links)
+	}
+	if links := graph.Links("FAQ"); len(links) != 0 {
+		t.Errorf("unexpected links: %v", links)
+	}
+	if backlinks := graph.Backlinks("FAQ"); !reflect.DeepEqual(backlinks, []string{"Guide", "Home"}) {
+		t.Errorf("unexpected backlinks: %v", backlinks)
+	}
+	if backlinks := graph.Backlinks("Home"); !reflect.DeepEqual(backlinks, []string{"Guide"}) {
+		t.Errorf("unexpected backlinks: %v", backlinks)
+	}
+}
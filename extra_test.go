@@ -2,9 +2,12 @@ package goldmark_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"os"
+	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -221,6 +224,25 @@ func TestDangerousURLStringCase(t *testing.T) {
 	}
 }
 
+func TestLowercaseDeclarations(t *testing.T) {
+	markdown := New(WithRendererOptions(
+		html.WithUnsafe(),
+	))
+	testutil.DoTestCase(
+		markdown,
+		testutil.MarkdownTestCase{
+			No:          1,
+			Description: "Declarations may start with a lowercase letter since CommonMark 0.31",
+			Markdown: `<!doctype html>
+
+a <!element b> c`,
+			Expected: `<!doctype html>
+<p>a <!element b> c</p>`,
+		},
+		t,
+	)
+}
+
 func TestNestedATXHeadingAttributes(t *testing.T) {
 	markdown := New(WithParserOptions(
 		parser.WithAutoHeadingID(),
@@ -245,3 +267,323 @@ func TestNestedATXHeadingAttributes(t *testing.T) {
 	}
 
 }
+
+func TestReferenceResolver(t *testing.T) {
+	glossary := map[string]parser.Reference{
+		"api guide": parser.NewReference([]byte("API guide"), []byte("https://example.com/api"), []byte("API")),
+		"local":     parser.NewReference([]byte("local"), []byte("https://example.com/glossary"), nil),
+	}
+	ctx := parser.NewContext(parser.WithReferenceResolver(func(label string) (parser.Reference, bool) {
+		ref, ok := glossary[label]
+		return ref, ok
+	}))
+	markdown := New()
+	source := []byte(`[API guide], [local][], [missing]
+
+[local]: /local
+`)
+	root := markdown.Parser().Parse(text.NewReader(source), parser.WithContext(ctx))
+
+	var links []*ast.Link
+	_ = ast.Walk(root, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if link, ok := n.(*ast.Link); ok && entering {
+			links = append(links, link)
+		}
+		return ast.WalkContinue, nil
+	})
+	if len(links) != 2 {
+		t.Fatalf("expected 2 links, got %d", len(links))
+	}
+	if !links[0].ExternalReference || string(links[0].Destination) != "https://example.com/api" {
+		t.Errorf("expected [API guide] to be resolved externally, got %q", links[0].Destination)
+	}
+	if links[1].ExternalReference || string(links[1].Destination) != "/local" {
+		t.Errorf("expected [local] to be resolved from the document, got %q", links[1].Destination)
+	}
+	if len(ctx.References()) != 1 {
+		t.Errorf("expected resolved references not to be added to the context")
+	}
+}
+
+func TestSession(t *testing.T) {
+	markdown := New(WithParserOptions(parser.WithAutoHeadingID()))
+	session := parser.NewSession()
+
+	convert := func(document, source string) string {
+		var b bytes.Buffer
+		if err := markdown.Convert([]byte(source), &b, parser.WithSession(session, document)); err != nil {
+			t.Fatal(err.Error())
+		}
+		return b.String()
+	}
+
+	actual := convert("intro", "# Introduction\n\n[api]: /api\n[shared]: /intro\n")
+	if actual != "<h1 id=\"introduction\">Introduction</h1>\n" {
+		t.Errorf("unexpected output for intro:\n%s", actual)
+	}
+	actual = convert("guide", "# Introduction\n\nSee [api] and [shared].\n\n[shared]: /guide\n")
+	if actual != "<h1 id=\"introduction-1\">Introduction</h1>\n<p>See <a href=\"/api\">api</a> and <a href=\"/guide\">shared</a>.</p>\n" {
+		t.Errorf("unexpected output for guide:\n%s", actual)
+	}
+
+	if ref, document, ok := session.Reference("API"); !ok || document != "intro" || string(ref.Destination()) != "/api" {
+		t.Errorf("expected [api] to be defined by intro, got %q", document)
+	}
+	if _, document, ok := session.Reference("shared"); !ok || document != "intro" {
+		t.Errorf("expected [shared] to be defined by intro, got %q", document)
+	}
+	if document, ok := session.ID([]byte("introduction-1")); !ok || document != "guide" {
+		t.Errorf("expected introduction-1 to be used by guide, got %q", document)
+	}
+
+	// Parsing a document again releases the ids it used before.
+	actual = convert("guide", "# Introduction\n")
+	if actual != "<h1 id=\"introduction-1\">Introduction</h1>\n" {
+		t.Errorf("unexpected output for guide:\n%s", actual)
+	}
+}
+
+func TestSessionPolicies(t *testing.T) {
+	markdown := New(WithParserOptions(parser.WithAutoHeadingID()))
+	session := parser.NewSession(
+		parser.WithReferencePrecedence(parser.ReferencePrecedenceLast),
+		parser.WithIDCollisionPolicy(parser.IDCollisionPrefix),
+	)
+
+	var b bytes.Buffer
+	_ = markdown.Convert([]byte("# Setup\n\n[x]: /a\n"), &b, parser.WithSession(session, "a"))
+	b.Reset()
+	_ = markdown.Convert([]byte("# Setup\n\n[x]\n\n[x]: /b\n"), &b, parser.WithSession(session, "b"))
+	if b.String() != "<h1 id=\"b-setup\">Setup</h1>\n<p><a href=\"/b\">x</a></p>\n" {
+		t.Errorf("unexpected output:\n%s", b.String())
+	}
+	b.Reset()
+	_ = markdown.Convert([]byte("[x]\n"), &b, parser.WithSession(session, "c"))
+	if b.String() != "<p><a href=\"/b\">x</a></p>\n" {
+		t.Errorf("unexpected output:\n%s", b.String())
+	}
+
+	// A document that is parsed again is added to the session last, and
+	// definitions it no longer contains are removed.
+	_ = markdown.Convert([]byte("[x]: /a2\n[y]: /y\n"), &b, parser.WithSession(session, "a"))
+	if _, document, ok := session.Reference("x"); !ok || document != "a" {
+		t.Errorf("expected [x] to be defined by a, got %q", document)
+	}
+	_ = markdown.Convert([]byte("[x]: /a3\n"), &b, parser.WithSession(session, "a"))
+	if _, _, ok := session.Reference("y"); ok {
+		t.Errorf("expected [y] to be removed")
+	}
+	if ref, _, _ := session.Reference("x"); string(ref.Destination()) != "/a3" {
+		t.Errorf("expected [x] to be /a3, got %q", ref.Destination())
+	}
+}
+
+func TestSessionParserIDs(t *testing.T) {
+	markdown := New(WithParserOptions(parser.WithAutoHeadingIDs(parser.NewGitHubIDs)))
+	session := parser.NewSession()
+
+	var b bytes.Buffer
+	_ = markdown.Convert([]byte("# Hello_World\n"), &b, parser.WithSession(session, "a"))
+	if b.String() != "<h1 id=\"hello_world\">Hello_World</h1>\n" {
+		t.Errorf("unexpected output for a:\n%s", b.String())
+	}
+	b.Reset()
+	_ = markdown.Convert([]byte("# Hello_World\n"), &b, parser.WithSession(session, "b"))
+	if b.String() != "<h1 id=\"hello_world-1\">Hello_World</h1>\n" {
+		t.Errorf("unexpected output for b:\n%s", b.String())
+	}
+}
+
+func TestArena(t *testing.T) {
+	bs, err := os.ReadFile("_test/spec.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var testCases []commonmarkSpecTestCase
+	if err := json.Unmarshal(bs, &testCases); err != nil {
+		t.Fatal(err)
+	}
+
+	markdown := New(WithRendererOptions(
+		html.WithXHTML(),
+		html.WithUnsafe(),
+	))
+	arena := parser.NewArena()
+	for _, c := range testCases {
+		// Reuse the arena to make sure that recycled nodes are reset.
+		arena.Reset()
+		source := []byte(c.Markdown)
+		doc := markdown.Parser().Parse(text.NewReader(source), parser.WithArena(arena))
+		var b bytes.Buffer
+		if err := markdown.Renderer().Render(&b, source, doc); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != c.HTML {
+			t.Errorf("example %d: unexpected output with an arena:\n%s", c.Example,
+				testutil.DiffPretty([]byte(c.HTML), b.Bytes()))
+		}
+	}
+}
+
+func TestLazyInlines(t *testing.T) {
+	bs, err := os.ReadFile("_test/spec.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var testCases []commonmarkSpecTestCase
+	if err := json.Unmarshal(bs, &testCases); err != nil {
+		t.Fatal(err)
+	}
+
+	markdown := New(WithRendererOptions(
+		html.WithXHTML(),
+		html.WithUnsafe(),
+	))
+	for _, c := range testCases {
+		source := []byte(c.Markdown)
+		doc := markdown.Parser().Parse(text.NewReader(source), parser.WithLazyInlines())
+		var b bytes.Buffer
+		if err := markdown.Renderer().Render(&b, source, doc); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != c.HTML {
+			t.Errorf("example %d: unexpected output with lazy inlines:\n%s", c.Example,
+				testutil.DiffPretty([]byte(c.HTML), b.Bytes()))
+		}
+	}
+}
+
+func TestParseInlines(t *testing.T) {
+	markdown := New()
+	source := []byte(`# *Title*
+
+- item with [a link](/url)
+`)
+	doc := markdown.Parser().Parse(text.NewReader(source), parser.WithLazyInlines())
+
+	heading := doc.FirstChild().(*ast.Heading)
+	if !heading.InlinesDeferred() || heading.HasChildren() {
+		t.Fatal("expected inlines of the heading to be deferred")
+	}
+	item := doc.LastChild().FirstChild()
+	if item.FirstChild().Kind() != ast.KindTextBlock {
+		t.Fatalf("expected blocks to be parsed, got %s", item.FirstChild().Kind())
+	}
+
+	parser.ParseInlines(heading)
+	if heading.InlinesDeferred() || heading.FirstChild().Kind() != ast.KindEmphasis {
+		t.Fatal("expected inlines of the heading to be parsed")
+	}
+	if !item.FirstChild().(*ast.TextBlock).InlinesDeferred() {
+		t.Fatal("expected inlines of other blocks to stay deferred")
+	}
+
+	parser.ParseInlines(doc)
+	if item.FirstChild().FirstChild().NextSibling().Kind() != ast.KindLink {
+		t.Fatal("expected inlines of the list item to be parsed")
+	}
+}
+
+func TestParallelInlines(t *testing.T) {
+	bs, err := os.ReadFile("_test/spec.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var testCases []commonmarkSpecTestCase
+	if err := json.Unmarshal(bs, &testCases); err != nil {
+		t.Fatal(err)
+	}
+	sources := [][]byte{}
+	for _, c := range testCases {
+		sources = append(sources, []byte(c.Markdown))
+	}
+	if data, err := os.ReadFile("_benchmark/go/_data.md"); err == nil {
+		sources = append(sources, data)
+	}
+
+	markdown := New()
+	for i, source := range sources {
+		serial := markdown.Parser().Parse(text.NewReader(source))
+		parallel := markdown.Parser().Parse(text.NewReader(source), parser.WithParallelInlines(4))
+
+		if !reflect.DeepEqual(serial, parallel) {
+			var expected, actual bytes.Buffer
+			serial.Dump(&expected, source, 0)
+			parallel.Dump(&actual, source, 0)
+			t.Errorf("source %d: parallel AST differs from serial AST:\n%s", i,
+				testutil.DiffPretty(expected.Bytes(), actual.Bytes()))
+		}
+	}
+}
+
+func TestConvertInline(t *testing.T) {
+	markdown := New()
+	cases := []struct {
+		source   string
+		expected string
+	}{
+		{"# *foo*", "# <em>foo</em>"},
+		{"1. foo", "1. foo"},
+		{"- [link](/url) and `code`  ", `- <a href="/url">link</a> and <code>code</code>`},
+		{"  first\n\n> second", "first\n&gt; second"},
+		{"", ""},
+	}
+	for i, c := range cases {
+		var b bytes.Buffer
+		if err := ConvertInlineWith(markdown, []byte(c.source), &b); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != c.expected {
+			t.Errorf("case %d: expected %q, got %q", i+1, c.expected, b.String())
+		}
+	}
+}
+
+func TestParseInline(t *testing.T) {
+	markdown := New()
+	source := []byte("[foo]\n")
+	c := parser.NewContext(parser.WithReferenceResolver(func(label string) (parser.Reference, bool) {
+		return parser.NewReference([]byte(label), []byte("/foo"), nil), true
+	}))
+	node := parser.ParseInline(markdown.Parser(), text.NewReader(source), parser.WithContext(c))
+	if node.Kind() != ast.KindTextBlock || node.ChildCount() != 1 {
+		t.Fatalf("expected a TextBlock with one child, got %s", node.Kind())
+	}
+	link, ok := node.FirstChild().(*ast.Link)
+	if !ok || string(link.Destination) != "/foo" {
+		t.Fatal("expected references to be resolved with the context")
+	}
+}
+
+func TestLazyInlinesConcurrentWalks(t *testing.T) {
+	markdown := New()
+	var source []byte
+	for i := 0; i < 1000; i++ {
+		source = append(source, "- *item* with [a link](/url)\n\n"...)
+	}
+	doc := markdown.Parser().Parse(text.NewReader(source), parser.WithLazyInlines())
+
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	counts := make([]int, 8)
+	for i := range counts {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+				if entering && n.Kind() == ast.KindLink {
+					counts[i]++
+				}
+				return ast.WalkContinue, nil
+			})
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+	for i, count := range counts {
+		if count != 1000 {
+			t.Errorf("walk %d: expected 1000 links, got %d", i, count)
+		}
+	}
+}
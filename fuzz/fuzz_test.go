@@ -55,3 +55,15 @@ func FuzzDefault(f *testing.F) {
 	}
 	fuzz(f)
 }
+
+func FuzzBibTeX(f *testing.F) {
+	bs, err := os.ReadFile("../extension/_test/citation.bib")
+	if err != nil {
+		panic(err)
+	}
+	f.Add(string(bs))
+	f.Add("@book{key, author = {İİİİİİ and Smith, John}}")
+	f.Fuzz(func(t *testing.T, orig string) {
+		_, _ = extension.ParseBibTeX([]byte(orig))
+	})
+}
@@ -2,6 +2,7 @@
 package goldmark
 
 import (
+	"errors"
 	"io"
 
 	"github.com/pgavlin/goldmark/parser"
@@ -32,6 +33,26 @@ func Convert(source []byte, w io.Writer, opts ...parser.ParseOption) error {
 	return defaultMarkdown.Convert(source, w, opts...)
 }
 
+// ConvertInline interprets a UTF-8 bytes source as inline Markdown contents
+// and write rendered contents to a writer w.
+func ConvertInline(source []byte, w io.Writer, opts ...parser.ParseOption) error {
+	return ConvertInlineWith(defaultMarkdown, source, w, opts...)
+}
+
+// ConvertInlineWith interprets a UTF-8 bytes source as inline Markdown
+// contents (see parser.FragmentParser) with the given Markdown and write
+// rendered contents to a writer w. Rendered contents are not wrapped in a
+// paragraph. AST transformers are not applied to inline contents.
+// ConvertInlineWith returns an error if the parser of the Markdown is not a
+// parser.FragmentParser.
+func ConvertInlineWith(m Markdown, source []byte, w io.Writer, opts ...parser.ParseOption) error {
+	node := parser.ParseInline(m.Parser(), text.NewReader(source), opts...)
+	if node == nil {
+		return errors.New("goldmark: the parser can not parse inline contents")
+	}
+	return m.Renderer().Render(w, source, node)
+}
+
 // A Markdown interface offers functions to convert Markdown text to
 // a desired format.
 type Markdown interface {
@@ -1,11 +1,13 @@
 package goldmark_test
 
 import (
+	"reflect"
 	"testing"
 
 	. "github.com/pgavlin/goldmark"
 	"github.com/pgavlin/goldmark/parser"
 	"github.com/pgavlin/goldmark/testutil"
+	"github.com/pgavlin/goldmark/text"
 )
 
 func TestAttributeAndAutoHeadingID(t *testing.T) {
@@ -17,3 +19,109 @@ func TestAttributeAndAutoHeadingID(t *testing.T) {
 	)
 	testutil.DoTestCaseFile(markdown, "_test/options.txt", t, testutil.ParseCliCaseArg()...)
 }
+
+func TestAutoHeadingIDs(t *testing.T) {
+	source := `# Überblick
+## 日本語の説明
+## Hello, World! 🎉
+## Hello, World! 🎉
+## C++ -- the  language
+## 2024
+## 👍️
+`
+	cases := []struct {
+		name     string
+		newIDs   func() parser.IDs
+		expected string
+	}{
+		{"GitHub", parser.NewGitHubIDs, `<h1 id="überblick">Überblick</h1>
+<h2 id="日本語の説明">日本語の説明</h2>
+<h2 id="hello-world-">Hello, World! 🎉</h2>
+<h2 id="hello-world--1">Hello, World! 🎉</h2>
+<h2 id="c----the--language">C++ -- the  language</h2>
+<h2 id="2024">2024</h2>
+<h2 id="heading">👍️</h2>`},
+		{"GitLab", parser.NewGitLabIDs, `<h1 id="überblick">Überblick</h1>
+<h2 id="日本語の説明">日本語の説明</h2>
+<h2 id="hello-world-">Hello, World! 🎉</h2>
+<h2 id="hello-world--1">Hello, World! 🎉</h2>
+<h2 id="c-the-language">C++ -- the  language</h2>
+<h2 id="anchor-2024">2024</h2>
+<h2 id="heading">👍️</h2>`},
+	}
+	for i, c := range cases {
+		markdown := New(
+			WithParserOptions(
+				parser.WithAutoHeadingIDs(c.newIDs),
+			),
+		)
+		testutil.DoTestCase(markdown, testutil.MarkdownTestCase{
+			No:          i + 1,
+			Description: c.name,
+			Markdown:    source,
+			Expected:    c.expected,
+		}, t)
+	}
+}
+
+func TestLenient(t *testing.T) {
+	source := []byte(`#Heading
+
+Some text
+2. two
+3) three
+
+1. one
+  - nested
+  - nested
+2. two
+`)
+	markdown := New(WithParserOptions(parser.WithLenient()))
+	testutil.DoTestCase(markdown, testutil.MarkdownTestCase{
+		No:          1,
+		Description: "lenient",
+		Markdown:    string(source),
+		Expected: `<h1>Heading</h1>
+<p>Some text</p>
+<ol start="2">
+<li>
+<p>two</p>
+</li>
+<li>
+<p>three</p>
+</li>
+<li>
+<p>one</p>
+<ul>
+<li>nested</li>
+<li>nested</li>
+</ul>
+</li>
+<li>
+<p>two</p>
+</li>
+</ol>`,
+	}, t)
+
+	pc := parser.NewContext()
+	markdown.Parser().Parse(text.NewReader(source), parser.WithContext(pc))
+	var diagnostics []string
+	for _, d := range parser.Diagnostics(pc) {
+		diagnostics = append(diagnostics, d.Format(source))
+	}
+	expected := []string{
+		"1:1: warning: ATX heading without a space after the opening sequence",
+		"4:1: warning: ordered list starting with 2 interrupts a paragraph",
+		"5:1: warning: list item delimiter ')' differs from the list delimiter '.'",
+		"8:3: warning: nested list is indented less than the content of its parent list item",
+	}
+	if !reflect.DeepEqual(diagnostics, expected) {
+		t.Errorf("expected diagnostics %q, but got %q", expected, diagnostics)
+	}
+
+	pc = parser.NewContext()
+	New().Parser().Parse(text.NewReader(source), parser.WithContext(pc))
+	if len(parser.Diagnostics(pc)) != 0 {
+		t.Errorf("expected no diagnostics without WithLenient, but got %v", parser.Diagnostics(pc))
+	}
+}
@@ -0,0 +1,242 @@
+package parser
+
+import (
+	"github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/text"
+	"github.com/pgavlin/goldmark/util"
+)
+
+// arenaChunkSize is a number of values allocated at once by an Arena.
+const arenaChunkSize = 256
+
+// arenaLinesPerBlock is a number of line segments reserved for a new block.
+const arenaLinesPerBlock = 4
+
+// slab is a chunked allocator of values of type T.
+type slab[T any] struct {
+	chunks [][]T
+	chunk  int
+	next   int
+}
+
+func (s *slab[T]) alloc() *T {
+	if s.chunk < len(s.chunks) && s.next == len(s.chunks[s.chunk]) {
+		s.chunk++
+		s.next = 0
+	}
+	if s.chunk == len(s.chunks) {
+		s.chunks = append(s.chunks, make([]T, arenaChunkSize))
+	}
+	v := &s.chunks[s.chunk][s.next]
+	s.next++
+	var zero T
+	*v = zero
+	return v
+}
+
+func (s *slab[T]) slice(n int) []T {
+	if n > arenaChunkSize {
+		return make([]T, 0, n)
+	}
+	if s.chunk < len(s.chunks) && s.next+n > len(s.chunks[s.chunk]) {
+		s.chunk++
+		s.next = 0
+	}
+	if s.chunk == len(s.chunks) {
+		s.chunks = append(s.chunks, make([]T, arenaChunkSize))
+	}
+	v := s.chunks[s.chunk][s.next : s.next : s.next+n]
+	s.next += n
+	return v
+}
+
+func (s *slab[T]) reset() {
+	s.chunk = 0
+	s.next = 0
+}
+
+// An Arena allocates AST nodes and line segments in large chunks instead of
+// one by one. An Arena can be passed to Parser.Parse with WithArena.
+//
+// Nodes allocated by an Arena remain valid until the Arena is reset, so an
+// Arena can be reused across Parse calls by calling Reset once the ASTs
+// that were parsed with it are no longer used. An Arena is not safe for
+// concurrent use.
+//
+// All methods of Arena can be called on a nil Arena. In that case, they
+// allocate values on the heap like the corresponding ast constructors.
+type Arena struct {
+	texts          slab[ast.Text]
+	paragraphs     slab[ast.Paragraph]
+	links          slab[ast.Link]
+	codeSpans      slab[ast.CodeSpan]
+	fencedCodes    slab[ast.FencedCodeBlock]
+	linkLabels     slab[linkLabelState]
+	fenceData      slab[fenceData]
+	segments       slab[text.Segment]
+	linkReferences []byte
+}
+
+// An ArenaContext is a Context that allocates nodes with an Arena. Contexts
+// returned by NewContext implement ArenaContext.
+type ArenaContext interface {
+	Context
+
+	// Arena returns an Arena that allocates nodes for this context.
+	// Arena returns nil if nodes should be allocated on the heap; the
+	// methods of Arena work with a nil Arena.
+	Arena() *Arena
+
+	// SetArena sets an Arena that allocates nodes for this context.
+	SetArena(*Arena)
+}
+
+// contextArena returns the Arena of the given context, or nil if the context
+// is not an ArenaContext.
+func contextArena(pc Context) *Arena {
+	if ac, ok := pc.(ArenaContext); ok {
+		return ac.Arena()
+	}
+	return nil
+}
+
+// NewArena returns a new empty Arena.
+func NewArena() *Arena {
+	return &Arena{}
+}
+
+// Reset makes the memory of all values allocated by this Arena available
+// for new values. Nodes allocated before Reset must not be used anymore.
+func (a *Arena) Reset() {
+	if a == nil {
+		return
+	}
+	a.texts.reset()
+	a.paragraphs.reset()
+	a.links.reset()
+	a.codeSpans.reset()
+	a.fencedCodes.reset()
+	a.linkLabels.reset()
+	a.fenceData.reset()
+	a.segments.reset()
+	a.linkReferences = a.linkReferences[:0]
+}
+
+// NewTextSegment returns a new Text node with the given source position.
+func (a *Arena) NewTextSegment(v text.Segment) *ast.Text {
+	if a == nil {
+		return ast.NewTextSegment(v)
+	}
+	t := a.texts.alloc()
+	t.Segment = v
+	return t
+}
+
+// NewRawTextSegment returns a new Text node with the given source position.
+// The new node should be rendered as raw contents.
+func (a *Arena) NewRawTextSegment(v text.Segment) *ast.Text {
+	if a == nil {
+		return ast.NewRawTextSegment(v)
+	}
+	t := a.NewTextSegment(v)
+	t.SetRaw(true)
+	return t
+}
+
+// NewParagraph returns a new Paragraph node.
+func (a *Arena) NewParagraph() *ast.Paragraph {
+	if a == nil {
+		return ast.NewParagraph()
+	}
+	return a.paragraphs.alloc()
+}
+
+// NewLink returns a new Link node.
+func (a *Arena) NewLink() *ast.Link {
+	if a == nil {
+		return ast.NewLink()
+	}
+	return a.links.alloc()
+}
+
+// NewCodeSpan returns a new CodeSpan node.
+func (a *Arena) NewCodeSpan(backticks int) *ast.CodeSpan {
+	if a == nil {
+		return ast.NewCodeSpan(backticks)
+	}
+	c := a.codeSpans.alloc()
+	c.Backticks = backticks
+	return c
+}
+
+// NewFencedCodeBlock returns a new FencedCodeBlock node.
+func (a *Arena) NewFencedCodeBlock(fence []byte, info *ast.Text) *ast.FencedCodeBlock {
+	if a == nil {
+		return ast.NewFencedCodeBlock(fence, info)
+	}
+	n := a.fencedCodes.alloc()
+	n.Fence = fence
+	n.Info = info
+	return n
+}
+
+// Segments returns an empty slice of segments with the given capacity.
+// Appending more than n segments to the slice reallocates it on the heap.
+func (a *Arena) Segments(n int) []text.Segment {
+	if a == nil {
+		return make([]text.Segment, 0, n)
+	}
+	return a.segments.slice(n)
+}
+
+func (a *Arena) newLinkLabelState(segment text.Segment, isImage bool) *linkLabelState {
+	if a == nil {
+		return newLinkLabelState(segment, isImage)
+	}
+	s := a.linkLabels.alloc()
+	s.Segment = segment
+	s.IsImage = isImage
+	return s
+}
+
+// linkReference returns a normalized link label like util.ToLinkReference.
+// Like nodes, the result remains valid until this arena is reset.
+func (a *Arena) linkReference(label []byte) string {
+	if a == nil {
+		return util.ToLinkReference(label)
+	}
+	start := len(a.linkReferences)
+	a.linkReferences = util.AppendLinkReference(a.linkReferences, label)
+	return util.BytesToReadOnlyString(a.linkReferences[start:])
+}
+
+// reserveLines moves the lines of the given block to segments allocated by
+// this arena.
+func (a *Arena) reserveLines(node ast.Node) {
+	if a == nil {
+		return
+	}
+	lines := node.Lines()
+	lines.SetBuffer(a.Segments(max(arenaLinesPerBlock, lines.Len())))
+}
+
+// growLines moves the lines of the given block to larger segments allocated
+// by this arena if the block can not hold another line.
+func (a *Arena) growLines(node ast.Node) {
+	if a == nil {
+		return
+	}
+	lines := node.Lines()
+	if n := lines.Len(); n != 0 && n == lines.Cap() {
+		lines.SetBuffer(a.Segments(2 * n))
+	}
+}
+
+func (a *Arena) newFenceData(char byte, indent, length int, node ast.Node) *fenceData {
+	if a == nil {
+		return &fenceData{char, indent, length, node}
+	}
+	d := a.fenceData.alloc()
+	*d = fenceData{char, indent, length, node}
+	return d
+}
@@ -10,6 +10,7 @@ import (
 type HeadingConfig struct {
 	AutoHeadingID bool
 	Attribute     bool
+	Lenient       bool
 }
 
 // SetOption implements SetOptioner.
@@ -19,6 +20,8 @@ func (b *HeadingConfig) SetOption(name OptionName, _ any) {
 		b.AutoHeadingID = true
 	case optAttribute:
 		b.Attribute = true
+	case optLenient:
+		b.Lenient = true
 	}
 }
 
@@ -48,6 +51,30 @@ func WithAutoHeadingID() HeadingOption {
 	return &withAutoHeadingID{}
 }
 
+type withAutoHeadingIDs struct {
+	value func() IDs
+}
+
+func (o *withAutoHeadingIDs) SetParserOption(c *Config) {
+	c.Options[optAutoHeadingID] = true
+	c.NewIDs = o.value
+}
+
+func (o *withAutoHeadingIDs) SetHeadingOption(p *HeadingConfig) {
+	p.AutoHeadingID = true
+}
+
+// WithAutoHeadingIDs is a functional option that enables auto generated
+// heading ids like WithAutoHeadingID, and generates them with IDs returned
+// by the given function, like NewGitHubIDs or NewGitLabIDs.
+// The function is called once for each parsed document, unless a Context
+// is given with WithContext.
+func WithAutoHeadingIDs(newIDs func() IDs) HeadingOption {
+	return &withAutoHeadingIDs{
+		value: newIDs,
+	}
+}
+
 type withHeadingAttribute struct {
 	Option
 }
@@ -96,7 +123,12 @@ func (b *atxHeadingParser) Open(parent ast.Node, reader text.Reader, pc Context)
 	}
 	l := util.TrimLeftSpaceLength(line[i:])
 	if l == 0 {
-		return nil, NoChildren
+		if !b.Lenient {
+			return nil, NoChildren
+		}
+		AddDiagnostic(pc, lenientDiagnostic(
+			text.NewSegment(segment.Start+pos-segment.Padding, segment.Start+i-segment.Padding),
+			"ATX heading without a space after the opening sequence"))
 	}
 
 	start := min(i+l, len(line)-1)
@@ -21,6 +21,10 @@ func (s *autoLinkParser) Trigger() []byte {
 	return []byte{'<'}
 }
 
+func (s *autoLinkParser) CanParseConcurrently() bool {
+	return true
+}
+
 func (s *autoLinkParser) Parse(parent ast.Node, block text.Reader, pc Context) ast.Node {
 	line, segment := block.PeekLine()
 	stop := util.FindEmailIndex(line[1:])
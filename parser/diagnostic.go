@@ -0,0 +1,99 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/pgavlin/goldmark/text"
+)
+
+// DiagnosticSeverity indicates how serious a Diagnostic is.
+type DiagnosticSeverity int
+
+const (
+	// DiagnosticError indicates that a part of the source could not be
+	// interpreted as the author probably intended.
+	DiagnosticError DiagnosticSeverity = iota
+
+	// DiagnosticWarning indicates that a part of the source was interpreted,
+	// but in a way that may not be portable or intended.
+	DiagnosticWarning
+)
+
+// String implements fmt.Stringer.
+func (s DiagnosticSeverity) String() string {
+	switch s {
+	case DiagnosticError:
+		return "error"
+	case DiagnosticWarning:
+		return "warning"
+	}
+	return fmt.Sprintf("DiagnosticSeverity(%d)", int(s))
+}
+
+// A Diagnostic describes a problem found while parsing Markdown text.
+type Diagnostic struct {
+	// Severity is a severity of this diagnostic.
+	Severity DiagnosticSeverity
+
+	// Segment is a part of the source this diagnostic refers to.
+	Segment text.Segment
+
+	// Message is a human readable description of this diagnostic.
+	Message string
+}
+
+// Position returns a 1-based line number and a 1-based column (in runes)
+// of the start of this diagnostic in the given source.
+func (d *Diagnostic) Position(source []byte) (line, column int) {
+	start := d.Segment.Start
+	if start > len(source) {
+		start = len(source)
+	}
+	before := source[:start]
+	line = bytes.Count(before, []byte{'\n'}) + 1
+	column = utf8.RuneCount(before[bytes.LastIndexByte(before, '\n')+1:]) + 1
+	return line, column
+}
+
+// Format returns a string like "3:5: error: message" for the given source.
+func (d *Diagnostic) Format(source []byte) string {
+	line, column := d.Position(source)
+	return fmt.Sprintf("%d:%d: %s: %s", line, column, d.Severity, d.Message)
+}
+
+// String implements fmt.Stringer.
+func (d *Diagnostic) String() string {
+	return fmt.Sprintf("Diagnostic{Severity:%s, Segment:[%d, %d), Message:%q}",
+		d.Severity, d.Segment.Start, d.Segment.Stop, d.Message)
+}
+
+// A DiagnosticContext is a Context that collects diagnostics. Contexts
+// returned by NewContext implement DiagnosticContext.
+type DiagnosticContext interface {
+	Context
+
+	// AddDiagnostic reports the given diagnostic.
+	AddDiagnostic(Diagnostic)
+
+	// Diagnostics returns a list of diagnostics reported so far.
+	Diagnostics() []Diagnostic
+}
+
+// AddDiagnostic reports the given diagnostic to the given context if the
+// context is a DiagnosticContext, otherwise the diagnostic is dropped.
+func AddDiagnostic(pc Context, d Diagnostic) {
+	if dc, ok := pc.(DiagnosticContext); ok {
+		dc.AddDiagnostic(d)
+	}
+}
+
+// Diagnostics returns a list of diagnostics reported to the given context
+// so far. Diagnostics returns nil if the context is not a DiagnosticContext.
+func Diagnostics(pc Context) []Diagnostic {
+	if dc, ok := pc.(DiagnosticContext); ok {
+		return dc.Diagnostics()
+	}
+	return nil
+}
@@ -35,6 +35,10 @@ func (s *emphasisParser) Trigger() []byte {
 	return []byte{'*', '_'}
 }
 
+func (s *emphasisParser) CanParseConcurrently() bool {
+	return true
+}
+
 func (s *emphasisParser) Parse(parent ast.Node, block text.Reader, pc Context) ast.Node {
 	before := block.PrecendingCharacter()
 	line, segment := block.PeekLine()
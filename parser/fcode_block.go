@@ -59,12 +59,12 @@ func (b *fencedCodeBlockParser) Open(parent ast.Node, reader text.Reader, pc Con
 			if fenceChar == '`' && bytes.IndexByte(value, '`') > -1 {
 				return nil, NoChildren
 			} else if infoStart != infoStop {
-				info = ast.NewTextSegment(text.NewSegment(infoStart, infoStop))
+				info = contextArena(pc).NewTextSegment(text.NewSegment(infoStart, infoStop))
 			}
 		}
 	}
-	node := ast.NewFencedCodeBlock(fence, info)
-	pc.Set(fencedCodeBlockInfoKey, &fenceData{fenceChar, findent, oFenceLength, node})
+	node := contextArena(pc).NewFencedCodeBlock(fence, info)
+	pc.Set(fencedCodeBlockInfoKey, contextArena(pc).newFenceData(fenceChar, findent, oFenceLength, node))
 	return node, NoChildren
 }
 
@@ -0,0 +1,114 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/util"
+)
+
+// sluggedIDs is an IDs that converts values with a slug function and
+// makes the results unique by appending '-1', '-2' and so on.
+type sluggedIDs struct {
+	slug        func(value string) string
+	occurrences map[string]int
+}
+
+// NewGitHubIDs returns a new IDs that generates ids the same way GitHub
+// generates anchors for headings: the value is lowercased, characters
+// other than letters, marks, numbers, '_', '-' and ' ' (including emoji)
+// are removed, and each ' ' is replaced with '-'.
+func NewGitHubIDs() IDs {
+	return &sluggedIDs{
+		slug:        gitHubSlug,
+		occurrences: map[string]int{},
+	}
+}
+
+// NewGitLabIDs returns a new IDs that generates ids the same way GitLab
+// generates anchors for headings. In addition to the GitHub rules,
+// consecutive '-' are squeezed into one and ids that consist only of
+// digits are prefixed with 'anchor-'.
+func NewGitLabIDs() IDs {
+	return &sluggedIDs{
+		slug:        gitLabSlug,
+		occurrences: map[string]int{},
+	}
+}
+
+func (s *sluggedIDs) Generate(value []byte, kind ast.NodeKind) []byte {
+	result := s.slug(string(util.TrimRightSpace(util.TrimLeftSpace(value))))
+	if len(result) == 0 {
+		if kind == ast.KindHeading {
+			result = "heading"
+		} else {
+			result = "id"
+		}
+	}
+	original := result
+	for {
+		if _, ok := s.occurrences[result]; !ok {
+			break
+		}
+		s.occurrences[original]++
+		result = fmt.Sprintf("%s-%d", original, s.occurrences[original])
+	}
+	s.occurrences[result] = 0
+	return []byte(result)
+}
+
+func (s *sluggedIDs) Put(value []byte) {
+	if _, ok := s.occurrences[string(value)]; !ok {
+		s.occurrences[string(value)] = 0
+	}
+}
+
+// isSlugRune returns true if the given rune is kept by the GitHub and
+// GitLab slug algorithms.
+func isSlugRune(r rune) bool {
+	if unicode.Is(unicode.Variation_Selector, r) {
+		// Variation selectors are marks, but only appear in emoji.
+		return false
+	}
+	return r == '-' || unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsNumber(r) || unicode.Is(unicode.Pc, r)
+}
+
+func gitHubSlug(value string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(value) {
+		if r == ' ' {
+			b.WriteByte('-')
+		} else if isSlugRune(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func gitLabSlug(value string) string {
+	var b strings.Builder
+	digitsOnly := true
+	for _, r := range strings.ToLower(value) {
+		if r == ' ' {
+			r = '-'
+		} else if !isSlugRune(r) {
+			continue
+		}
+		if r == '-' {
+			if last, _ := utf8.DecodeLastRuneInString(b.String()); last == '-' {
+				continue
+			}
+		}
+		if r < '0' || r > '9' {
+			digitsOnly = false
+		}
+		b.WriteRune(r)
+	}
+	if digitsOnly && b.Len() != 0 {
+		return "anchor-" + b.String()
+	}
+	return b.String()
+}
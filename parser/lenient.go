@@ -0,0 +1,105 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/text"
+)
+
+// Lenient is an option name that enables the lenient profile of heading and
+// list parsers.
+const optLenient OptionName = "Lenient"
+
+// A LenientOption interface sets the lenient profile for heading and list
+// parsers.
+type LenientOption interface {
+	HeadingOption
+	ListOption
+}
+
+type withLenient struct {
+}
+
+func (o *withLenient) SetParserOption(c *Config) {
+	c.Options[optLenient] = true
+}
+
+func (o *withLenient) SetHeadingOption(p *HeadingConfig) {
+	p.Lenient = true
+}
+
+func (o *withLenient) SetListOption(p *ListConfig) {
+	p.Lenient = true
+}
+
+// WithLenient is a functional option that makes the ATX heading and list
+// parsers accept the following forms that are rejected by CommonMark but
+// accepted by many older Markdown engines:
+//
+//   - ATX headings without a space after the opening sequence, like '#Heading'.
+//   - Ordered lists that do not start with 1 and interrupt a paragraph.
+//   - Nested lists indented less than the content of their parent item, like
+//     a list indented with 2 spaces in an ordered list item.
+//   - Ordered list items whose delimiter differs from the previous item, like
+//     '1)' after '1.'.
+//
+// Each use of these forms is reported as a DiagnosticWarning.
+func WithLenient() LenientOption {
+	return &withLenient{}
+}
+
+// lenientListItemIndentsKey is a key of a map from list items to the
+// indentation of their markers. It is only used in the lenient profile.
+var lenientListItemIndentsKey = NewContextKey()
+
+func setListItemMarkerIndent(item *ast.ListItem, indent int, pc Context) {
+	indents, _ := pc.Get(lenientListItemIndentsKey).(map[*ast.ListItem]int)
+	if indents == nil {
+		indents = map[*ast.ListItem]int{}
+		pc.Set(lenientListItemIndentsKey, indents)
+	}
+	indents[item] = indent
+}
+
+// clearListItemMarkerIndents forgets the marker indents of the items of the
+// given list once the list is closed.
+func clearListItemMarkerIndents(list ast.Node, pc Context) {
+	indents, _ := pc.Get(lenientListItemIndentsKey).(map[*ast.ListItem]int)
+	if indents == nil {
+		return
+	}
+	for c := list.FirstChild(); c != nil; c = c.NextSibling() {
+		if item, ok := c.(*ast.ListItem); ok {
+			delete(indents, item)
+		}
+	}
+	if len(indents) == 0 {
+		pc.Set(lenientListItemIndentsKey, nil)
+	}
+}
+
+// isLenientNestedListItem returns true if the given line is a list item that
+// is indented at least 2 spaces more than the marker of the given item but
+// less than its content, so that it starts a nested list in the lenient
+// profile.
+func isLenientNestedListItem(item ast.Node, line []byte, indent int, pc Context) bool {
+	if indent < 2 || indent >= 4 {
+		return false
+	}
+	indents, _ := pc.Get(lenientListItemIndentsKey).(map[*ast.ListItem]int)
+	markerIndent, ok := indents[item.(*ast.ListItem)]
+	if !ok || indent-markerIndent < 2 {
+		return false
+	}
+	_, typ := matchesListItem(line, true)
+	return typ != notList && !isThematicBreak(line, 0)
+}
+
+func lenientDiagnostic(segment text.Segment, format string, args ...any) Diagnostic {
+	return Diagnostic{
+		Severity: DiagnosticWarning,
+		Segment:  segment,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
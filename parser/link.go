@@ -120,6 +120,10 @@ func (s *linkParser) Trigger() []byte {
 	return []byte{'!', '[', ']'}
 }
 
+func (s *linkParser) CanParseConcurrently() bool {
+	return true
+}
+
 var linkBottom = NewContextKey()
 
 func (s *linkParser) Parse(parent ast.Node, block text.Reader, pc Context) ast.Node {
@@ -191,18 +195,19 @@ func (s *linkParser) Parse(parent ast.Node, block text.Reader, pc Context) ast.N
 			return nil
 		}
 
-		ref, ok := pc.Reference(util.ToLinkReference(maybeReference))
+		ref, external, ok := lookupReference(pc, maybeReference)
 		if !ok {
 			ast.MergeOrReplaceTextSegment(last.Parent(), last, last.Segment)
 			_ = popLinkBottom(pc)
 			return nil
 		}
-		link = ast.NewLink()
+		link = contextArena(pc).NewLink()
 		s.processLinkLabel(parent, link, last, pc)
 		link.ReferenceType = ast.LinkShortcutReference
 		link.Label = maybeReference
 		link.Title = ref.Title()
 		link.Destination = ref.Destination()
+		link.ExternalReference = external
 	}
 	if last.IsImage {
 		last.Parent().RemoveChild(last.Parent(), last)
@@ -232,7 +237,7 @@ func processLinkLabelOpen(block text.Reader, pos int, isImage bool, pc Context)
 	if isImage {
 		start--
 	}
-	state := newLinkLabelState(text.NewSegment(start, pos+1), isImage)
+	state := contextArena(pc).newLinkLabelState(text.NewSegment(start, pos+1), isImage)
 	pushLinkLabelState(pc, state)
 	block.Advance(1)
 	return state
@@ -286,20 +291,35 @@ func (s *linkParser) parseReferenceLink(parent ast.Node, last *linkLabelState,
 		return nil, true
 	}
 
-	ref, ok := pc.Reference(util.ToLinkReference(maybeReference))
+	ref, external, ok := lookupReference(pc, maybeReference)
 	if !ok {
 		return nil, true
 	}
 
-	link := ast.NewLink()
+	link := contextArena(pc).NewLink()
 	s.processLinkLabel(parent, link, last, pc)
 	link.ReferenceType = referenceType
 	link.Label = maybeReference
 	link.Title = ref.Title()
 	link.Destination = ref.Destination()
+	link.ExternalReference = external
 	return link, true
 }
 
+// lookupReference looks up the given label in the document's definitions
+// and then in the context's ReferenceResolver. The second return value is
+// true if the reference was supplied by the resolver.
+func lookupReference(pc Context, label []byte) (Reference, bool, bool) {
+	key := contextArena(pc).linkReference(label)
+	if ref, ok := pc.Reference(key); ok {
+		return ref, false, true
+	}
+	if ref, ok := ResolveReference(pc, key); ok {
+		return ref, true, true
+	}
+	return nil, false, false
+}
+
 func (s *linkParser) parseLink(parent ast.Node, last *linkLabelState, block text.Reader, pc Context) *ast.Link {
 	block.Advance(1) // skip '('
 	block.SkipSpaces()
@@ -330,7 +350,7 @@ func (s *linkParser) parseLink(parent ast.Node, last *linkLabelState, block text
 		}
 	}
 
-	link := ast.NewLink()
+	link := contextArena(pc).NewLink()
 	s.processLinkLabel(parent, link, last, pc)
 	link.Destination = destination
 	link.Title = title
@@ -88,6 +88,12 @@ func matchesListItem(source []byte, strict bool) ([6]int, listItemType) {
 	return m, notList
 }
 
+// markerSegment returns a source segment of the list marker matched in the
+// given line.
+func markerSegment(segment text.Segment, match [6]int) text.Segment {
+	return text.NewSegment(segment.Start+match[2]-segment.Padding, segment.Start+match[3]-segment.Padding)
+}
+
 func calcListOffset(source []byte, match [6]int) int {
 	var offset int
 	if match[4] < 0 || util.IsBlank(source[match[4]:]) { // list item starts with a blank line
@@ -109,16 +115,37 @@ func lastOffset(node ast.Node) int {
 	return 0
 }
 
-type listParser struct {
+// A ListConfig struct is a data structure that holds configuration of the list parsers.
+type ListConfig struct {
+	Lenient bool
 }
 
-var defaultListParser = &listParser{}
+// SetOption implements SetOptioner.
+func (b *ListConfig) SetOption(name OptionName, _ any) {
+	if name == optLenient {
+		b.Lenient = true
+	}
+}
+
+// A ListOption interface sets options for list parsers.
+type ListOption interface {
+	Option
+	SetListOption(*ListConfig)
+}
+
+type listParser struct {
+	ListConfig
+}
 
 // NewListParser returns a new BlockParser that
 // parses lists.
 // This parser must take precedence over the ListItemParser.
-func NewListParser() BlockParser {
-	return defaultListParser
+func NewListParser(opts ...ListOption) BlockParser {
+	p := &listParser{}
+	for _, o := range opts {
+		o.SetListOption(&p.ListConfig)
+	}
+	return p
 }
 
 func (b *listParser) Trigger() []byte {
@@ -131,7 +158,7 @@ func (b *listParser) Open(parent ast.Node, reader text.Reader, pc Context) (ast.
 		pc.Set(skipListParserKey, nil)
 		return nil, NoChildren
 	}
-	line, _ := reader.PeekLine()
+	line, segment := reader.PeekLine()
 	match, typ := matchesListItem(line, true)
 	if typ == notList {
 		return nil, NoChildren
@@ -144,13 +171,17 @@ func (b *listParser) Open(parent ast.Node, reader text.Reader, pc Context) (ast.
 
 	if ast.IsParagraph(last) && last.Parent() == parent {
 		// we allow only lists starting with 1 to interrupt paragraphs.
-		if typ == orderedList && start != 1 {
-			return nil, NoChildren
-		}
 		//an empty list item cannot interrupt a paragraph:
 		if match[4] < 0 || util.IsBlank(line[match[4]:match[5]]) {
 			return nil, NoChildren
 		}
+		if typ == orderedList && start != 1 {
+			if !b.Lenient {
+				return nil, NoChildren
+			}
+			AddDiagnostic(pc, lenientDiagnostic(markerSegment(segment, match),
+				"ordered list starting with %d interrupts a paragraph", start))
+		}
 	}
 
 	marker := line[match[3]-1]
@@ -164,7 +195,7 @@ func (b *listParser) Open(parent ast.Node, reader text.Reader, pc Context) (ast.
 
 func (b *listParser) Continue(node ast.Node, reader text.Reader, pc Context) State {
 	list := node.(*ast.List)
-	line, _ := reader.PeekLine()
+	line, segment := reader.PeekLine()
 	if util.IsBlank(line) {
 		if node.LastChild().ChildCount() == 0 {
 			pc.Set(emptyListItemWithBlankLines, listItemFlagValue)
@@ -197,12 +228,19 @@ func (b *listParser) Continue(node ast.Node, reader text.Reader, pc Context) Sta
 	indent, _ := util.IndentWidth(line, reader.LineOffset())
 
 	if indent < offset || lastIsEmpty {
+		if b.Lenient && !lastIsEmpty && isLenientNestedListItem(node.LastChild(), line, indent, pc) {
+			return Continue | HasChildren
+		}
 		if indent < 4 {
 			match, typ := matchesListItem(line, false) // may have a leading spaces more than 3
 			if typ != notList && match[1]-offset < 4 {
 				marker := line[match[3]-1]
 				if !list.CanContinue(marker, typ == orderedList) {
-					return Close
+					if !b.Lenient || typ != orderedList || !list.IsOrdered() {
+						return Close
+					}
+					AddDiagnostic(pc, lenientDiagnostic(markerSegment(segment, match),
+						"list item delimiter '%c' differs from the list delimiter '%c'", marker, list.Marker))
 				}
 				// Thematic Breaks take precedence over lists
 				if isThematicBreak(line[match[3]-1:], 0) {
@@ -246,6 +284,9 @@ func (b *listParser) Continue(node ast.Node, reader text.Reader, pc Context) Sta
 
 func (b *listParser) Close(node ast.Node, reader text.Reader, pc Context) {
 	list := node.(*ast.List)
+	if b.Lenient {
+		clearListItemMarkerIndents(node, pc)
+	}
 
 	for c := node.FirstChild(); c != nil && list.IsTight; c = c.NextSibling() {
 		if c.FirstChild() != nil && c.FirstChild() != c.LastChild() {
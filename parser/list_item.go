@@ -7,14 +7,17 @@ import (
 )
 
 type listItemParser struct {
+	ListConfig
 }
 
-var defaultListItemParser = &listItemParser{}
-
 // NewListItemParser returns a new BlockParser that
 // parses list items.
-func NewListItemParser() BlockParser {
-	return defaultListItemParser
+func NewListItemParser(opts ...ListOption) BlockParser {
+	p := &listItemParser{}
+	for _, o := range opts {
+		o.SetListOption(&p.ListConfig)
+	}
+	return p
 }
 
 func (b *listItemParser) Trigger() []byte {
@@ -27,7 +30,7 @@ func (b *listItemParser) Open(parent ast.Node, reader text.Reader, pc Context) (
 		return nil, NoChildren
 	}
 	offset := lastOffset(list)
-	line, _ := reader.PeekLine()
+	line, segment := reader.PeekLine()
 	match, typ := matchesListItem(line, false)
 	if typ == notList {
 		return nil, NoChildren
@@ -40,6 +43,14 @@ func (b *listItemParser) Open(parent ast.Node, reader text.Reader, pc Context) (
 
 	itemOffset := calcListOffset(line, match)
 	node := ast.NewListItem(match[3] + itemOffset)
+	if b.Lenient {
+		// Leading whitespace is set by the parser if the marker is indented,
+		// but it always ends where the marker starts, so that renderers can
+		// find markers of lists that have been renumbered.
+		marker := markerSegment(segment, match)
+		node.SetLeadingWhitespace(text.NewSegment(marker.Start, marker.Start))
+		setListItemMarkerIndent(node, match[1], pc)
+	}
 	if match[4] < 0 || util.IsBlank(line[match[4]:match[5]]) {
 		return node, NoChildren
 	}
@@ -51,7 +62,7 @@ func (b *listItemParser) Open(parent ast.Node, reader text.Reader, pc Context) (
 }
 
 func (b *listItemParser) Continue(node ast.Node, reader text.Reader, pc Context) State {
-	line, _ := reader.PeekLine()
+	line, segment := reader.PeekLine()
 	if util.IsBlank(line) {
 		reader.AdvanceToEOL()
 		return Continue | HasChildren
@@ -60,7 +71,14 @@ func (b *listItemParser) Continue(node ast.Node, reader text.Reader, pc Context)
 	offset := lastOffset(node.Parent())
 	isEmpty := node.ChildCount() == 0 && pc.Get(emptyListItemWithBlankLines) != nil
 	indent, _ := util.IndentWidth(line, reader.LineOffset())
-	if (isEmpty || indent < offset) && indent < 4 {
+	if b.Lenient && !isEmpty && indent < offset && isLenientNestedListItem(node, line, indent, pc) {
+		// Following lines of this item are indented like the nested list.
+		node.(*ast.ListItem).Offset = indent
+		offset = indent
+		match, _ := matchesListItem(line, true)
+		AddDiagnostic(pc, lenientDiagnostic(markerSegment(segment, match),
+			"nested list is indented less than the content of its parent list item"))
+	} else if (isEmpty || indent < offset) && indent < 4 {
 		_, typ := matchesListItem(line, true)
 		// new list item found
 		if typ != notList {
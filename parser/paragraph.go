@@ -27,7 +27,7 @@ func (b *paragraphParser) Open(parent ast.Node, reader text.Reader, pc Context)
 	if segment.IsEmpty() {
 		return nil, NoChildren
 	}
-	node := ast.NewParagraph()
+	node := contextArena(pc).NewParagraph()
 	node.Lines().Append(segment)
 	reader.AdvanceToEOL()
 	return node, NoChildren
@@ -0,0 +1,135 @@
+package parser
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/text"
+)
+
+// WithParallelInlines is a functional option that parses inline elements
+// of top-level blocks concurrently with the given number of workers.
+// If workers is less than 1, runtime.GOMAXPROCS(0) workers are used.
+//
+// Inline elements are parsed concurrently only if all inline parsers
+// implement ConcurrentInlineParser and can parse concurrently; otherwise
+// they are parsed serially. The resulting AST is identical to the AST
+// parsed serially.
+//
+// Each top-level block is parsed with its own Context whose values are
+// initially empty. References, the ReferenceResolver and IDs are shared
+// with the Context of the document, so the ReferenceResolver must be safe
+// for concurrent use. Calls to the shared IDs are serialized. Nodes are not
+// allocated with the Arena of the document.
+func WithParallelInlines(workers int) ParseOption {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	return func(c *ParseConfig) {
+		c.ParallelInlines = workers
+	}
+}
+
+// blockContext is a Context for parsing inline elements of a top-level
+// block concurrently with other blocks. Values, delimiters and diagnostics
+// are local to the block.
+type blockContext struct {
+	*parseContext
+	shared Context
+	ids    IDs
+}
+
+func newBlockContext(shared Context, ids IDs) *blockContext {
+	return &blockContext{
+		parseContext: &parseContext{
+			store:        make([]interface{}, ContextKeyMax+1),
+			blockOffset:  -1,
+			blockIndent:  -1,
+			openedBlocks: []Block{},
+		},
+		shared: shared,
+		ids:    ids,
+	}
+}
+
+func (c *blockContext) String() string {
+	return c.shared.String()
+}
+
+func (c *blockContext) AddReference(ref Reference) {
+	c.shared.AddReference(ref)
+}
+
+func (c *blockContext) Reference(label string) (Reference, bool) {
+	return c.shared.Reference(label)
+}
+
+func (c *blockContext) References() []Reference {
+	return c.shared.References()
+}
+
+func (c *blockContext) ResolveReference(label string) (Reference, bool) {
+	return ResolveReference(c.shared, label)
+}
+
+func (c *blockContext) IDs() IDs {
+	return c.ids
+}
+
+// syncIDs serializes calls to IDs shared by blockContexts.
+type syncIDs struct {
+	mutex sync.Mutex
+	ids   IDs
+}
+
+func (s *syncIDs) Generate(value []byte, kind ast.NodeKind) []byte {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.ids.Generate(value, kind)
+}
+
+func (s *syncIDs) Put(value []byte) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.ids.Put(value)
+}
+
+func (p *parser) parseInlinesConcurrently(root ast.Node, source []byte, workers int, pc Context) {
+	var blocks []ast.Node
+	for c := root.FirstChild(); c != nil; c = c.NextSibling() {
+		blocks = append(blocks, c)
+	}
+	contexts := make([]*blockContext, len(blocks))
+	ids := &syncIDs{ids: pc.IDs()}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(workers, len(blocks)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reader := text.NewBlockReader(source, nil)
+			for i := range jobs {
+				bc := newBlockContext(pc, ids)
+				contexts[i] = bc
+				p.walkBlock(blocks[i], func(node ast.Node) {
+					p.parseBlock(reader, node, bc)
+				})
+			}
+		}()
+	}
+	for i := range blocks {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	// Report diagnostics in the same order as a serial parse.
+	for _, bc := range contexts {
+		for _, d := range bc.diagnostics {
+			AddDiagnostic(pc, d)
+		}
+	}
+	p.parseBlock(text.NewBlockReader(source, nil), root, pc)
+}
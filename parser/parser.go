@@ -204,9 +204,38 @@ type Context interface {
 	IsInLinkLabel() bool
 }
 
+// A ReferenceResolvingContext is a Context that can resolve link references
+// that are not defined in the document being parsed. Contexts returned by
+// NewContext implement ReferenceResolvingContext.
+type ReferenceResolvingContext interface {
+	Context
+
+	// ResolveReference returns (a reference, true) if the ReferenceResolver
+	// of this context resolves the given label, otherwise (nil, false).
+	// References returned by ResolveReference are not added to this context.
+	ResolveReference(label string) (Reference, bool)
+}
+
+// ResolveReference resolves the given label with the given context if the
+// context is a ReferenceResolvingContext. ResolveReference returns
+// (nil, false) if the context can not resolve references.
+func ResolveReference(pc Context, label string) (Reference, bool) {
+	if rc, ok := pc.(ReferenceResolvingContext); ok {
+		return rc.ResolveReference(label)
+	}
+	return nil, false
+}
+
+// A ReferenceResolver resolves link references that are not defined
+// in the document being parsed. The label is normalized as if by
+// util.ToLinkReference. A ReferenceResolver returns (nil, false) if
+// the label is unknown.
+type ReferenceResolver func(label string) (Reference, bool)
+
 // A ContextConfig struct is a data structure that holds configuration of the Context.
 type ContextConfig struct {
-	IDs IDs
+	IDs               IDs
+	ReferenceResolver ReferenceResolver
 }
 
 // An ContextOption is a functional option type for the Context.
@@ -219,10 +248,22 @@ func WithIDs(ids IDs) ContextOption {
 	}
 }
 
+// WithReferenceResolver is a functional option for the Context.
+// The given resolver is consulted when a link label has no definition
+// in the document.
+func WithReferenceResolver(resolver ReferenceResolver) ContextOption {
+	return func(c *ContextConfig) {
+		c.ReferenceResolver = resolver
+	}
+}
+
 type parseContext struct {
 	store         []interface{}
 	ids           IDs
 	refs          map[string]Reference
+	resolver      ReferenceResolver
+	diagnostics   []Diagnostic
+	arena         *Arena
 	blockOffset   int
 	blockIndent   int
 	delimiters    *Delimiter
@@ -243,6 +284,7 @@ func NewContext(options ...ContextOption) Context {
 		store:         make([]interface{}, ContextKeyMax+1),
 		refs:          map[string]Reference{},
 		ids:           cfg.IDs,
+		resolver:      cfg.ReferenceResolver,
 		blockOffset:   -1,
 		blockIndent:   -1,
 		delimiters:    nil,
@@ -272,6 +314,22 @@ func (p *parseContext) IDs() IDs {
 	return p.ids
 }
 
+func (p *parseContext) AddDiagnostic(d Diagnostic) {
+	p.diagnostics = append(p.diagnostics, d)
+}
+
+func (p *parseContext) Diagnostics() []Diagnostic {
+	return p.diagnostics
+}
+
+func (p *parseContext) Arena() *Arena {
+	return p.arena
+}
+
+func (p *parseContext) SetArena(v *Arena) {
+	p.arena = v
+}
+
 func (p *parseContext) BlockOffset() int {
 	return p.blockOffset
 }
@@ -361,6 +419,18 @@ func (p *parseContext) Reference(label string) (Reference, bool) {
 	return v, ok
 }
 
+func (p *parseContext) ResolveReference(label string) (Reference, bool) {
+	if p.resolver == nil {
+		return nil, false
+	}
+	// label may be backed by a buffer of an Arena, so resolvers get a copy.
+	v, ok := p.resolver(strings.Clone(label))
+	if !ok || v == nil {
+		return nil, false
+	}
+	return v, true
+}
+
 func (p *parseContext) References() []Reference {
 	ret := make([]Reference, 0, len(p.refs))
 	for _, v := range p.refs {
@@ -432,6 +502,9 @@ type Config struct {
 	ParagraphTransformers util.PrioritizedSlice /*<ParagraphTransformer>*/
 	ASTTransformers       util.PrioritizedSlice /*<ASTTransformer>*/
 	EscapedSpace          bool
+
+	// NewIDs returns a new IDs for a Context that is created by the Parser.
+	NewIDs func() IDs
 }
 
 // NewConfig returns a new Config.
@@ -477,6 +550,32 @@ type Parser interface {
 	AddOptions(...Option)
 }
 
+// A FragmentParser is a Parser that can parse Markdown text as inline
+// contents only. Parsers returned by NewParser implement FragmentParser.
+type FragmentParser interface {
+	Parser
+
+	// ParseInline parses the given Markdown text as inline contents only,
+	// without block level elements like headings and lists. ParseInline
+	// returns an ast.TextBlock whose children are the parsed inline nodes.
+	// The TextBlock is a child of an ast.Document so that it can be
+	// rendered like a whole document, without a wrapping paragraph.
+	//
+	// AST transformers are not applied to the result, because they expect
+	// whole documents.
+	ParseInline(reader text.Reader, opts ...ParseOption) ast.Node
+}
+
+// ParseInline parses the given Markdown text as inline contents only with
+// the given parser (see FragmentParser.ParseInline). ParseInline returns nil
+// if the parser is not a FragmentParser.
+func ParseInline(p Parser, reader text.Reader, opts ...ParseOption) ast.Node {
+	if fp, ok := p.(FragmentParser); ok {
+		return fp.ParseInline(reader, opts...)
+	}
+	return nil
+}
+
 // A SetOptioner interface sets the given option to the object.
 type SetOptioner interface {
 	// SetOption sets the given option to the object.
@@ -549,9 +648,26 @@ type InlineParser interface {
 // called when block is closed in the inline parsing.
 type CloseBlocker interface {
 	// CloseBlock will be called when a block is closed.
+	//
+	// The inline children of parent may be being parsed lazily (see
+	// WithLazyInlines), so CloseBlock must walk the children of parent
+	// instead of parent itself: ast.Walk would parse the inlines of parent
+	// again.
 	CloseBlock(parent ast.Node, block text.Reader, pc Context)
 }
 
+// A ConcurrentInlineParser interface is implemented by InlineParsers that
+// can parse inline elements of different blocks concurrently.
+// See WithParallelInlines.
+type ConcurrentInlineParser interface {
+	InlineParser
+
+	// CanParseConcurrently returns true if this parser keeps all of its
+	// state in the Context, does not depend on the order in which blocks
+	// are parsed and does not use Context.IDs.
+	CanParseConcurrently() bool
+}
+
 // A ParagraphTransformer transforms parsed Paragraph nodes.
 // For example, link references are searched in parsed Paragraphs.
 type ParagraphTransformer interface {
@@ -565,6 +681,19 @@ type ASTTransformer interface {
 	Transform(node *ast.Document, reader text.Reader, pc Context)
 }
 
+// A LazyASTTransformer interface is implemented by ASTTransformers that
+// can transform documents whose inlines have not been parsed yet.
+// See WithLazyInlines.
+type LazyASTTransformer interface {
+	ASTTransformer
+
+	// CanTransformLazily returns true if this transformer does not depend
+	// on state that inline parsers keep in the Context, and walks blocks
+	// whose inline children it uses with ast.Walk or passes them to
+	// ParseInlines.
+	CanTransformLazily() bool
+}
+
 // DefaultBlockParsers returns a new list of default BlockParsers.
 // Priorities of default BlockParsers are:
 //
@@ -638,6 +767,9 @@ type parser struct {
 	paragraphTransformers []ParagraphTransformer
 	astTransformers       []ASTTransformer
 	escapedSpace          bool
+	serialInlines         bool
+	eagerTransformers     bool
+	newIDs                func() IDs
 	config                *Config
 	initSync              sync.Once
 }
@@ -785,6 +917,9 @@ func (p *parser) addInlineParser(v util.PrioritizedValue, options map[OptionName
 	if cb, ok := ip.(CloseBlocker); ok {
 		p.closeBlockers = append(p.closeBlockers, cb)
 	}
+	if cp, ok := ip.(ConcurrentInlineParser); !ok || !cp.CanParseConcurrently() {
+		p.serialInlines = true
+	}
 	for _, tc := range tcs {
 		if p.inlineParsers[tc] == nil {
 			p.inlineParsers[tc] = []InlineParser{}
@@ -818,12 +953,22 @@ func (p *parser) addASTTransformer(v util.PrioritizedValue, options map[OptionNa
 			so.SetOption(oname, ovalue)
 		}
 	}
+	if lt, ok := at.(LazyASTTransformer); !ok || !lt.CanTransformLazily() {
+		p.eagerTransformers = true
+	}
 	p.astTransformers = append(p.astTransformers, at)
 }
 
 // A ParseConfig struct is a data structure that holds configuration of the Parser.Parse.
 type ParseConfig struct {
-	Context Context
+	Context         Context
+	Arena           *Arena
+	LazyInlines     bool
+	ParallelInlines int
+
+	session        *Session
+	document       string
+	sessionOptions []ContextOption
 }
 
 // A ParseOption is a functional option type for the Parser.Parse.
@@ -837,48 +982,138 @@ func WithContext(context Context) ParseOption {
 	}
 }
 
-func (p *parser) Parse(reader text.Reader, opts ...ParseOption) ast.Node {
-	p.initSync.Do(func() {
-		p.config.BlockParsers.Sort()
-		for _, v := range p.config.BlockParsers {
-			p.addBlockParser(v, p.config.Options)
-		}
-		for i := range p.blockParsers {
-			if p.blockParsers[i] != nil {
-				p.blockParsers[i] = append(p.blockParsers[i], p.freeBlockParsers...)
-			}
-		}
+// WithArena is a functional option that allocates nodes with the given
+// Arena. The Arena can be reused across Parse calls; see Arena for details.
+// WithArena has no effect if a Context given with WithContext is not an
+// ArenaContext.
+func WithArena(arena *Arena) ParseOption {
+	return func(c *ParseConfig) {
+		c.Arena = arena
+	}
+}
 
-		p.config.InlineParsers.Sort()
-		for _, v := range p.config.InlineParsers {
-			p.addInlineParser(v, p.config.Options)
-		}
-		p.config.ParagraphTransformers.Sort()
-		for _, v := range p.config.ParagraphTransformers {
-			p.addParagraphTransformer(v, p.config.Options)
-		}
-		p.config.ASTTransformers.Sort()
-		for _, v := range p.config.ASTTransformers {
-			p.addASTTransformer(v, p.config.Options)
+// WithLazyInlines is a functional option that defers parsing of inline
+// elements. Inline children of a block are parsed when ast.Walk walks them
+// for the first time or when ParseInlines is called. Blocks whose children
+// are accessed directly (i.e. with Node.FirstChild) must be passed to
+// ParseInlines beforehand.
+//
+// The source and the Context of a lazily parsed document must stay valid
+// until its inlines have been parsed. Deferred inlines are parsed once even if
+// the document is walked concurrently.
+//
+// ASTTransformers run before deferred inlines are parsed, so they must be
+// LazyASTTransformers. Extensions like footnotes collect state for their
+// transformers while inlines are parsed, so if the parser has an
+// ASTTransformer that can not transform lazily, inline elements are parsed
+// before the transformers run and a warning is reported as a Diagnostic.
+func WithLazyInlines() ParseOption {
+	return func(c *ParseConfig) {
+		c.LazyInlines = true
+	}
+}
+
+// ParseInlines parses the deferred inline children of the given node and
+// its descendants. See WithLazyInlines.
+func ParseInlines(node ast.Node) {
+	_ = ast.Walk(node, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if n.Type() == ast.TypeInline {
+			return ast.WalkSkipChildren, nil
 		}
-		p.escapedSpace = p.config.EscapedSpace
-		p.config = nil
+		return ast.WalkContinue, nil
 	})
+}
+
+// deferrableBlock is implemented by blocks that can defer parsing of
+// their inline children, like blocks that embed ast.BaseBlock.
+type deferrableBlock interface {
+	SetInlinesDeferred(bool)
+}
+
+func (p *parser) initialize() {
+	p.config.BlockParsers.Sort()
+	for _, v := range p.config.BlockParsers {
+		p.addBlockParser(v, p.config.Options)
+	}
+	for i := range p.blockParsers {
+		if p.blockParsers[i] != nil {
+			p.blockParsers[i] = append(p.blockParsers[i], p.freeBlockParsers...)
+		}
+	}
+
+	p.config.InlineParsers.Sort()
+	for _, v := range p.config.InlineParsers {
+		p.addInlineParser(v, p.config.Options)
+	}
+	p.config.ParagraphTransformers.Sort()
+	for _, v := range p.config.ParagraphTransformers {
+		p.addParagraphTransformer(v, p.config.Options)
+	}
+	p.config.ASTTransformers.Sort()
+	for _, v := range p.config.ASTTransformers {
+		p.addASTTransformer(v, p.config.Options)
+	}
+	p.escapedSpace = p.config.EscapedSpace
+	p.newIDs = p.config.NewIDs
+	p.config = nil
+}
+
+// newParseConfig applies the given options and returns a ParseConfig with
+// a Context.
+func (p *parser) newParseConfig(opts []ParseOption) *ParseConfig {
 	c := &ParseConfig{}
 	for _, opt := range opts {
 		opt(c)
 	}
-	if c.Context == nil {
-		c.Context = NewContext()
+	if c.session != nil {
+		c.Context = c.session.newContext(c.document, p.newIDs, c.sessionOptions)
+	} else if c.Context == nil {
+		if p.newIDs != nil {
+			c.Context = NewContext(WithIDs(p.newIDs()))
+		} else {
+			c.Context = NewContext()
+		}
+	}
+	if ac, ok := c.Context.(ArenaContext); ok && c.Arena != nil {
+		ac.SetArena(c.Arena)
 	}
+	return c
+}
+
+func (p *parser) Parse(reader text.Reader, opts ...ParseOption) ast.Node {
+	p.initSync.Do(p.initialize)
+	c := p.newParseConfig(opts)
 	pc := c.Context
 	root := ast.NewDocument()
 	p.parseBlocks(root, reader, pc)
 
 	blockReader := text.NewBlockReader(reader.Source(), nil)
-	p.walkBlock(root, func(node ast.Node) {
-		p.parseBlock(blockReader, node, pc)
-	})
+	lazy := c.LazyInlines
+	if lazy && p.eagerTransformers {
+		lazy = false
+		AddDiagnostic(pc, Diagnostic{
+			Severity: DiagnosticWarning,
+			Message:  "inlines are not parsed lazily, because an AST transformer can not transform lazily",
+		})
+	}
+	if lazy {
+		p.walkBlock(root, func(node ast.Node) {
+			if d, ok := node.(deferrableBlock); ok && !node.IsRaw() {
+				d.SetInlinesDeferred(true)
+			} else {
+				p.parseBlock(blockReader, node, pc)
+			}
+		})
+		root.SetInlineParser(func(node ast.Node) {
+			p.parseBlock(blockReader, node, pc)
+		})
+	} else if c.ParallelInlines > 1 && !p.serialInlines {
+		p.parseInlinesConcurrently(root, reader.Source(), c.ParallelInlines, pc)
+	} else {
+		p.walkBlock(root, func(node ast.Node) {
+			p.parseBlock(blockReader, node, pc)
+		})
+	}
 	for _, at := range p.astTransformers {
 		at.Transform(root, reader, pc)
 	}
@@ -1005,6 +1240,7 @@ retry:
 				}
 			}
 
+			contextArena(pc).reserveLines(node)
 			node.SetBlankPreviousLines(blankLine)
 			if last != nil && last.Parent() == nil {
 				lastPos := len(pc.OpenedBlocks()) - 1
@@ -1024,6 +1260,7 @@ retry:
 
 continuable:
 	if result == noBlocksOpened && continuable {
+		contextArena(pc).growLines(lastBlock.Node)
 		state := lastBlock.Parser.Continue(lastBlock.Node, reader, pc)
 		if state&Continue != 0 {
 			result = paragraphContinuation
@@ -1088,6 +1325,7 @@ func (p *parser) parseBlocks(parent ast.Node, reader text.Reader, pc Context) {
 				// If node is a paragraph, p.openBlocks determines whether it is continuable.
 				// So we do not process paragraphs here.
 				if !ast.IsParagraph(be.Node) {
+					contextArena(pc).growLines(be.Node)
 					state := be.Parser.Continue(be.Node, reader, pc)
 					if state&Continue != 0 {
 						// When current node is a container block and has no children,
@@ -1124,6 +1362,46 @@ func (p *parser) parseBlocks(parent ast.Node, reader text.Reader, pc Context) {
 	}
 }
 
+func (p *parser) ParseInline(reader text.Reader, opts ...ParseOption) ast.Node {
+	p.initSync.Do(p.initialize)
+	c := p.newParseConfig(opts)
+	pc := c.Context
+	source := reader.Source()
+
+	root := ast.NewDocument()
+	block := ast.NewTextBlock()
+	for {
+		line, segment := reader.PeekLine()
+		if line == nil {
+			break
+		}
+		// Blank lines can not be a part of inline contents.
+		if !util.IsBlank(line) {
+			block.Lines().Append(segment.TrimLeftSpace(source))
+		}
+		reader.AdvanceLine()
+	}
+	if length := block.Lines().Len(); length != 0 {
+		lastLine := block.Lines().At(length - 1)
+		block.Lines().Set(length-1, lastLine.TrimRightSpace(source))
+	}
+	root.AppendChild(root, block)
+	p.parseBlock(text.NewBlockReader(source, nil), block, pc)
+	return block
+}
+
+// mergeOrAppendTextSegment is like ast.MergeOrAppendTextSegment, but
+// allocates new Text nodes with the arena of the given context.
+func mergeOrAppendTextSegment(parent ast.Node, s text.Segment, pc Context) {
+	last := parent.LastChild()
+	t, ok := last.(*ast.Text)
+	if ok && t.Segment.Stop == s.Start && !t.SoftLineBreak() {
+		t.Segment = t.Segment.WithStop(s.Stop)
+	} else {
+		parent.AppendChild(parent, contextArena(pc).NewTextSegment(s))
+	}
+}
+
 func (p *parser) walkBlock(block ast.Node, cb func(node ast.Node)) {
 	for c := block.FirstChild(); c != nil; c = c.NextSibling() {
 		p.walkBlock(c, cb)
@@ -1185,7 +1463,7 @@ func (p *parser) parseBlock(block text.BlockReader, parent ast.Node, pc Context)
 		} else if hasNewLine {
 			// If the line ends with a newline character, but it is not a hardlineBreak, then it is a softLinebreak
 			// If the line ends with a hardlineBreak, then it cannot end with a softLinebreak
-			// See https://spec.commonmark.org/0.30/#soft-line-breaks
+			// See https://spec.commonmark.org/0.31.2/#soft-line-breaks
 			lineBreakFlags |= lineBreakSoft
 		}
 
@@ -1210,7 +1488,7 @@ func (p *parser) parseBlock(block text.BlockReader, parent ast.Node, pc Context)
 					savedLine, savedPosition := block.Position()
 					if i != 0 {
 						_, currentPosition := block.Position()
-						ast.MergeOrAppendTextSegment(parent, startPosition.Between(currentPosition))
+						mergeOrAppendTextSegment(parent, startPosition.Between(currentPosition), pc)
 						_, startPosition = block.Position()
 					}
 					var inlineNode ast.Node
@@ -1252,9 +1530,9 @@ func (p *parser) parseBlock(block text.BlockReader, parent ast.Node, pc Context)
 		diff := startPosition.Between(currentPosition)
 		var text *ast.Text
 		if lineBreakFlags&(lineBreakHard|lineBreakVisible) == lineBreakHard|lineBreakVisible {
-			text = ast.NewTextSegment(diff)
+			text = contextArena(pc).NewTextSegment(diff)
 		} else {
-			text = ast.NewTextSegment(diff.TrimRightSpace(source))
+			text = contextArena(pc).NewTextSegment(diff.TrimRightSpace(source))
 		}
 		text.SetSoftLineBreak(lineBreakFlags&lineBreakSoft != 0)
 		text.SetHardLineBreak(lineBreakFlags&lineBreakHard != 0)
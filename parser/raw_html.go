@@ -24,6 +24,10 @@ func (s *rawHTMLParser) Trigger() []byte {
 	return []byte{'<'}
 }
 
+func (s *rawHTMLParser) CanParseConcurrently() bool {
+	return true
+}
+
 func (s *rawHTMLParser) Parse(parent ast.Node, block text.Reader, pc Context) ast.Node {
 	line, _ := block.PeekLine()
 	if len(line) > 1 && util.IsAlphaNumeric(line[1]) {
@@ -38,7 +42,7 @@ func (s *rawHTMLParser) Parse(parent ast.Node, block text.Reader, pc Context) as
 	if bytes.HasPrefix(line, openProcessingInstruction) {
 		return s.parseUntil(block, closeProcessingInstruction, pc)
 	}
-	if len(line) > 2 && line[1] == '!' && line[2] >= 'A' && line[2] <= 'Z' {
+	if len(line) > 2 && line[1] == '!' && (line[2] >= 'A' && line[2] <= 'Z' || line[2] >= 'a' && line[2] <= 'z') {
 		return s.parseUntil(block, closeDecl, pc)
 	}
 	if bytes.HasPrefix(line, openCDATA) {
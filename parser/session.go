@@ -0,0 +1,327 @@
+package parser
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/util"
+)
+
+// ReferencePrecedence defines how a Session chooses between several
+// definitions of the same link reference label.
+type ReferencePrecedence int
+
+const (
+	// ReferencePrecedenceLocal indicates that a definition in the document
+	// being parsed wins over definitions in other documents. Otherwise, the
+	// definition that was added to the session first wins.
+	ReferencePrecedenceLocal ReferencePrecedence = iota
+
+	// ReferencePrecedenceFirst indicates that the definition that was added
+	// to the session first wins, even over a definition in the document being parsed.
+	ReferencePrecedenceFirst
+
+	// ReferencePrecedenceLast indicates that the definition that was added
+	// to the session last wins.
+	ReferencePrecedenceLast
+)
+
+// IDCollisionPolicy defines how a Session handles an element id that has
+// already been used by another document.
+type IDCollisionPolicy int
+
+const (
+	// IDCollisionSuffix indicates that a numeric suffix like "-1" is
+	// appended to colliding ids, as is done within a single document.
+	IDCollisionSuffix IDCollisionPolicy = iota
+
+	// IDCollisionPrefix indicates that colliding ids are prefixed with the
+	// name of the document followed by '-'. If the prefixed id collides as
+	// well, a numeric suffix is appended.
+	IDCollisionPrefix
+
+	// IDCollisionIgnore indicates that ids are only unique within each document.
+	// Session.ID returns the first document that used a colliding id.
+	IDCollisionIgnore
+)
+
+// A SessionConfig struct is a data structure that holds configuration of the Session.
+type SessionConfig struct {
+	ReferencePrecedence ReferencePrecedence
+	IDCollisionPolicy   IDCollisionPolicy
+
+	// NewIDs returns a new IDs that generates ids within a single document.
+	// The Session resolves collisions between documents on top of it.
+	// If NewIDs is nil, documents that are parsed WithSession use the IDs
+	// of the Parser, like those given to WithAutoHeadingIDs.
+	NewIDs func() IDs
+}
+
+// A SessionOption is a functional option type for the Session.
+type SessionOption func(*SessionConfig)
+
+// WithReferencePrecedence is a functional option that sets how a Session
+// chooses between several definitions of the same label.
+func WithReferencePrecedence(precedence ReferencePrecedence) SessionOption {
+	return func(c *SessionConfig) {
+		c.ReferencePrecedence = precedence
+	}
+}
+
+// WithIDCollisionPolicy is a functional option that sets how a Session
+// handles ids that have already been used by another document.
+func WithIDCollisionPolicy(policy IDCollisionPolicy) SessionOption {
+	return func(c *SessionConfig) {
+		c.IDCollisionPolicy = policy
+	}
+}
+
+// WithSessionIDs is a functional option that sets a function that returns
+// the IDs used to generate ids within each document of a Session.
+func WithSessionIDs(f func() IDs) SessionOption {
+	return func(c *SessionConfig) {
+		c.NewIDs = f
+	}
+}
+
+type sessionReference struct {
+	ref      Reference
+	document string
+}
+
+// A Session holds a reference table and an id namespace that are shared by
+// the documents parsed within it.
+//
+// Reference definitions become visible to other documents once the document
+// that defines them has been parsed. Parsing a document again within the same
+// session replaces the ids and the reference definitions it added previously,
+// so documents can be parsed twice in order to resolve references to documents that come later.
+//
+// A Session is safe for concurrent use.
+type Session struct {
+	config SessionConfig
+
+	mutex sync.Mutex
+	refs  map[string][]sessionReference
+	ids   map[string]string
+}
+
+// NewSession returns a new Session.
+func NewSession(opts ...SessionOption) *Session {
+	config := SessionConfig{
+		ReferencePrecedence: ReferencePrecedenceLocal,
+		IDCollisionPolicy:   IDCollisionSuffix,
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	return &Session{
+		config: config,
+		refs:   map[string][]sessionReference{},
+		ids:    map[string]string{},
+	}
+}
+
+// WithSession is a functional option that parses a document named document
+// within the given session. It overrides WithContext.
+func WithSession(session *Session, document string, opts ...ContextOption) ParseOption {
+	return func(c *ParseConfig) {
+		c.session = session
+		c.document = document
+		c.sessionOptions = opts
+	}
+}
+
+// NewContext returns a new Context for parsing the document named document
+// within this session. Ids and reference definitions that were added for the
+// document by an earlier Context are released, so definitions of the
+// document are ordered as if it was added to the session last.
+func (s *Session) NewContext(document string, opts ...ContextOption) Context {
+	return s.newContext(document, nil, opts)
+}
+
+// newContext returns a new Context for the document named document. Ids
+// within the document are generated by IDs returned by parserIDs unless
+// the session is configured WithSessionIDs.
+func (s *Session) newContext(document string, parserIDs func() IDs, opts []ContextOption) Context {
+	s.mutex.Lock()
+	for id, d := range s.ids {
+		if d == document {
+			delete(s.ids, id)
+		}
+	}
+	for label, refs := range s.refs {
+		kept := refs[:0]
+		for _, sr := range refs {
+			if sr.document != document {
+				kept = append(kept, sr)
+			}
+		}
+		if len(kept) == 0 {
+			delete(s.refs, label)
+		} else {
+			s.refs[label] = kept
+		}
+	}
+	s.mutex.Unlock()
+
+	documentIDs := s.config.NewIDs
+	if documentIDs == nil {
+		documentIDs = parserIDs
+	}
+	if documentIDs == nil {
+		documentIDs = newIDs
+	}
+	ids := &sessionIDs{
+		session:  s,
+		document: document,
+		ids:      documentIDs(),
+	}
+	opts = append([]ContextOption{WithIDs(ids)}, opts...)
+	return &sessionContext{
+		parseContext: NewContext(opts...).(*parseContext),
+		session:      s,
+		document:     document,
+	}
+}
+
+// Reference returns the reference associated with the given label and the
+// name of the document that defined it.
+// Reference returns (nil, "", false) if no document defines the label.
+func (s *Session) Reference(label string) (Reference, string, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	sr, ok := s.reference(util.ToLinkReference([]byte(label)))
+	return sr.ref, sr.document, ok
+}
+
+// References returns the references in this session. If several documents
+// define the same label, only the reference that wins is returned.
+func (s *Session) References() []Reference {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	ret := make([]Reference, 0, len(s.refs))
+	for label := range s.refs {
+		sr, _ := s.reference(label)
+		ret = append(ret, sr.ref)
+	}
+	return ret
+}
+
+// ID returns the name of the document that used the given element id.
+func (s *Session) ID(id []byte) (string, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	document, ok := s.ids[string(id)]
+	return document, ok
+}
+
+func (s *Session) reference(label string) (sessionReference, bool) {
+	refs := s.refs[label]
+	if len(refs) == 0 {
+		return sessionReference{}, false
+	}
+	if s.config.ReferencePrecedence == ReferencePrecedenceLast {
+		return refs[len(refs)-1], true
+	}
+	return refs[0], true
+}
+
+func (s *Session) addReference(document string, ref Reference) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	label := util.ToLinkReference(ref.Label())
+	refs := s.refs[label]
+	for _, sr := range refs {
+		if sr.document == document {
+			// The first definition in a document wins, as in a Context.
+			return
+		}
+	}
+	s.refs[label] = append(refs, sessionReference{ref: ref, document: document})
+}
+
+func (s *Session) generateID(document string, id []byte) []byte {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if d, ok := s.ids[string(id)]; ok && d != document && s.config.IDCollisionPolicy != IDCollisionIgnore {
+		if s.config.IDCollisionPolicy == IDCollisionPrefix {
+			id = []byte(document + "-" + string(id))
+		}
+		if d, ok := s.ids[string(id)]; ok && d != document {
+			for i := 1; ; i++ {
+				newID := fmt.Sprintf("%s-%d", id, i)
+				if _, ok := s.ids[newID]; !ok {
+					id = []byte(newID)
+					break
+				}
+			}
+		}
+	}
+	if _, ok := s.ids[string(id)]; !ok {
+		s.ids[string(id)] = document
+	}
+	return id
+}
+
+func (s *Session) putID(document string, id []byte) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.ids[string(id)]; !ok {
+		s.ids[string(id)] = document
+	}
+}
+
+type sessionIDs struct {
+	session  *Session
+	document string
+	ids      IDs
+}
+
+func (s *sessionIDs) Generate(value []byte, kind ast.NodeKind) []byte {
+	id := s.ids.Generate(value, kind)
+	result := s.session.generateID(s.document, id)
+	if string(result) != string(id) {
+		s.ids.Put(result)
+	}
+	return result
+}
+
+func (s *sessionIDs) Put(value []byte) {
+	s.ids.Put(value)
+	s.session.putID(s.document, value)
+}
+
+type sessionContext struct {
+	*parseContext
+	session  *Session
+	document string
+}
+
+func (c *sessionContext) AddReference(ref Reference) {
+	c.parseContext.AddReference(ref)
+	c.session.addReference(c.document, ref)
+}
+
+func (c *sessionContext) Reference(label string) (Reference, bool) {
+	if c.session.config.ReferencePrecedence == ReferencePrecedenceLocal {
+		if ref, ok := c.parseContext.Reference(label); ok {
+			return ref, true
+		}
+	}
+
+	c.session.mutex.Lock()
+	defer c.session.mutex.Unlock()
+
+	if sr, ok := c.session.reference(label); ok {
+		return sr.ref, true
+	}
+	return c.parseContext.Reference(label)
+}
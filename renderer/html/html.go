@@ -333,7 +333,7 @@ func (r *Renderer) renderBlockquote(
 		if n.Attributes() != nil {
 			_, _ = w.WriteString("<blockquote")
 			RenderAttributes(w, n, BlockquoteAttributeFilter)
-			_ = w.WriteByte('>')
+			_, _ = w.WriteString(">\n")
 		} else {
 			_, _ = w.WriteString("<blockquote>\n")
 		}
@@ -343,9 +343,16 @@ func (r *Renderer) renderBlockquote(
 	return ast.WalkContinue, nil
 }
 
+// CodeBlockAttributeFilter defines attribute names which pre elements can have.
+var CodeBlockAttributeFilter = GlobalAttributeFilter
+
 func (r *Renderer) renderCodeBlock(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
 	if entering {
-		_, _ = w.WriteString("<pre><code>")
+		_, _ = w.WriteString("<pre")
+		if n.Attributes() != nil {
+			RenderAttributes(w, n, CodeBlockAttributeFilter)
+		}
+		_, _ = w.WriteString("><code>")
 		r.writeLines(w, source, n)
 	} else {
 		_, _ = w.WriteString("</code></pre>\n")
@@ -357,7 +364,11 @@ func (r *Renderer) renderFencedCodeBlock(
 	w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
 	n := node.(*ast.FencedCodeBlock)
 	if entering {
-		_, _ = w.WriteString("<pre><code")
+		_, _ = w.WriteString("<pre")
+		if n.Attributes() != nil {
+			RenderAttributes(w, n, CodeBlockAttributeFilter)
+		}
+		_, _ = w.WriteString("><code")
 		language := n.Language(source)
 		if language != nil {
 			_, _ = w.WriteString(" class=\"language-")
@@ -730,13 +741,16 @@ func RenderAttributes(w util.BufWriter, node ast.Node, filter util.BytesFilter)
 		_, _ = w.WriteString(" ")
 		_, _ = w.Write(attr.Name)
 		_, _ = w.WriteString(`="`)
-		// TODO: convert numeric values to strings
 		var value []byte
 		switch typed := attr.Value.(type) {
 		case []byte:
 			value = typed
 		case string:
 			value = util.StringToReadOnlyBytes(typed)
+		case float64:
+			value = strconv.AppendFloat(nil, typed, 'f', -1, 64)
+		case bool:
+			value = strconv.AppendBool(nil, typed)
 		}
 		_, _ = w.Write(util.EscapeHTML(value))
 		_ = w.WriteByte('"')
@@ -9,6 +9,7 @@ import (
 	"unicode/utf8"
 
 	"github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/parser"
 	"github.com/pgavlin/goldmark/renderer"
 	"github.com/pgavlin/goldmark/text"
 	"github.com/pgavlin/goldmark/util"
@@ -38,6 +39,39 @@ type Renderer struct {
 	prefixStack []string
 	prefix      []byte
 	atNewline   bool
+
+	externalReferenceDefinitions bool
+	externalReferences           []externalReference
+	externalReferenceLabels      map[string]bool
+}
+
+type externalReference struct {
+	label       []byte
+	destination []byte
+	title       []byte
+}
+
+// An Option is a functional option type for the Renderer.
+type Option func(*Renderer)
+
+// WithExternalReferenceDefinitions is a functional option that makes the Renderer append a link reference
+// definition to the end of the document for each reference that was supplied by a parser.ReferenceResolver rather
+// than defined in the source. Without this option, such references are written as-is and require the same resolver
+// in order to be parsed again.
+func WithExternalReferenceDefinitions() Option {
+	return func(r *Renderer) {
+		r.externalReferenceDefinitions = true
+	}
+}
+
+// NewRenderer returns a new Renderer with the given options. The zero value of Renderer is equivalent to
+// NewRenderer().
+func NewRenderer(opts ...Option) *Renderer {
+	r := &Renderer{}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
 }
 
 // RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
@@ -184,6 +218,115 @@ func (r *Renderer) PopPrefix() {
 	r.prefixStack = r.prefixStack[:len(r.prefixStack)-1]
 }
 
+// WriteAttributes writes the given attributes in the form accepted by parser.ParseAttributes, e.g.
+// '{#id .class key="value"}'. WriteAttributes writes nothing if there are no attributes.
+func (r *Renderer) WriteAttributes(w io.Writer, attrs []ast.Attribute) error {
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, attr := range attrs {
+		if i != 0 {
+			buf.WriteByte(' ')
+		}
+		switch name := string(attr.Name); {
+		case name == "id" && isAttributeShorthand(attr.Value):
+			buf.WriteByte('#')
+			buf.Write(attr.Value.([]byte))
+			continue
+		case name == "class" && isAttributeShorthand(attr.Value):
+			for j, class := range bytes.Fields(attr.Value.([]byte)) {
+				if j != 0 {
+					buf.WriteByte(' ')
+				}
+				buf.WriteByte('.')
+				buf.Write(class)
+			}
+			continue
+		}
+		buf.Write(attr.Name)
+		buf.WriteByte('=')
+		writeAttributeValue(&buf, attr.Value)
+	}
+	buf.WriteByte('}')
+
+	_, err := r.Write(w, buf.Bytes())
+	return err
+}
+
+// isAttributeShorthand returns true if the given value can be written using the '#id' or '.class' forms.
+func isAttributeShorthand(value interface{}) bool {
+	v, ok := value.([]byte)
+	if !ok || len(bytes.TrimSpace(v)) == 0 {
+		return false
+	}
+	for _, c := range v {
+		if util.IsPunct(c) && c != '_' && c != '-' && c != ':' && c != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+func writeAttributeValue(buf *bytes.Buffer, value interface{}) {
+	switch v := value.(type) {
+	case []byte:
+		writeAttributeString(buf, v)
+	case string:
+		writeAttributeString(buf, []byte(v))
+	case float64:
+		buf.WriteString(strconv.FormatFloat(v, 'f', -1, 64))
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case nil:
+		buf.WriteString("null")
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, e := range v {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			writeAttributeValue(buf, e)
+		}
+		buf.WriteByte(']')
+	case parser.Attributes:
+		buf.WriteByte('{')
+		for i, attr := range v {
+			if i != 0 {
+				buf.WriteByte(' ')
+			}
+			buf.Write(attr.Name)
+			buf.WriteByte('=')
+			writeAttributeValue(buf, attr.Value)
+		}
+		buf.WriteByte('}')
+	default:
+		writeAttributeString(buf, []byte(fmt.Sprint(v)))
+	}
+}
+
+func writeAttributeString(buf *bytes.Buffer, v []byte) {
+	buf.WriteByte('"')
+	for _, c := range v {
+		switch c {
+		case '"', '\\':
+			buf.WriteByte('\\')
+			buf.WriteByte(c)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	buf.WriteByte('"')
+}
+
 // OpenBlock ensures that each block begins on a new line, and that blank lines are inserted before blocks as
 // indicated by node.HasPreviousBlankLines.
 func (r *Renderer) OpenBlock(w util.BufWriter, source []byte, node ast.Node) error {
@@ -231,10 +374,74 @@ func (r *Renderer) CloseBlock(w io.Writer) error {
 
 // RenderDocument renders an *ast.Document node to the given BufWriter.
 func (r *Renderer) RenderDocument(w util.BufWriter, source []byte, node ast.Node, enter bool) (ast.WalkStatus, error) {
-	r.listStack, r.prefixStack, r.prefix, r.atNewline = nil, nil, nil, false
+	if enter {
+		r.listStack, r.prefixStack, r.prefix, r.atNewline = nil, nil, nil, false
+		r.externalReferences, r.externalReferenceLabels = nil, nil
+		return ast.WalkContinue, nil
+	}
+
+	if err := r.renderExternalReferenceDefinitions(w); err != nil {
+		return ast.WalkStop, err
+	}
 	return ast.WalkContinue, nil
 }
 
+func (r *Renderer) addExternalReference(refType ast.LinkReferenceType, label, dest, title []byte) {
+	if !r.externalReferenceDefinitions || refType == ast.LinkNoReference {
+		return
+	}
+
+	key := util.ToLinkReference(label)
+	if r.externalReferenceLabels[key] {
+		return
+	}
+	if r.externalReferenceLabels == nil {
+		r.externalReferenceLabels = map[string]bool{}
+	}
+	r.externalReferenceLabels[key] = true
+	r.externalReferences = append(r.externalReferences, externalReference{
+		label:       label,
+		destination: dest,
+		title:       title,
+	})
+}
+
+func (r *Renderer) renderExternalReferenceDefinitions(w util.BufWriter) error {
+	for i, ref := range r.externalReferences {
+		if i == 0 {
+			if err := r.WriteByte(w, '\n'); err != nil {
+				return err
+			}
+		}
+		if err := r.WriteByte(w, '['); err != nil {
+			return err
+		}
+		if _, err := r.Write(w, ref.label); err != nil {
+			return err
+		}
+		if _, err := r.WriteString(w, "]: "); err != nil {
+			return err
+		}
+		dest := r.escapeLinkDest(ref.destination)
+		if len(dest) == 0 {
+			dest = []byte("<>")
+		}
+		if _, err := r.Write(w, dest); err != nil {
+			return err
+		}
+		if len(ref.title) != 0 {
+			delimiter := r.linkTitleDelimiter(ref.title)
+			if _, err := fmt.Fprintf(w, ` %c%s%c`, delimiter, string(ref.title), delimiter); err != nil {
+				return err
+			}
+		}
+		if err := r.WriteByte(w, '\n'); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // RenderHeading renders an *ast.Heading node to the given BufWriter.
 func (r *Renderer) RenderHeading(w util.BufWriter, source []byte, node ast.Node, enter bool) (ast.WalkStatus, error) {
 	if enter {
@@ -435,11 +642,21 @@ func (r *Renderer) RenderLinkReferenceDefinition(w util.BufWriter, source []byte
 // RenderList renders an *ast.List node to the given BufWriter.
 func (r *Renderer) RenderList(w util.BufWriter, source []byte, node ast.Node, enter bool) (ast.WalkStatus, error) {
 	if enter {
+		list := node.(*ast.List)
+
+		// An ordered list that does not start with 1 can not interrupt a paragraph.
+		if list.IsOrdered() && list.Start != 1 && !node.HasBlankPreviousLines() {
+			if prev := node.PreviousSibling(); prev != nil && prev.Kind() == ast.KindParagraph {
+				if err := r.WriteByte(w, '\n'); err != nil {
+					return ast.WalkStop, err
+				}
+			}
+		}
+
 		if err := r.OpenBlock(w, source, node); err != nil {
 			return ast.WalkStop, err
 		}
 
-		list := node.(*ast.List)
 		r.listStack = append(r.listStack, listState{
 			marker:  list.Marker,
 			ordered: list.IsOrdered(),
@@ -481,7 +698,17 @@ func (r *Renderer) RenderListItem(w util.BufWriter, source []byte, node ast.Node
 
 		ws := node.LeadingWhitespace()
 		offset := markerWidth + ws.Len()
-		if o := node.(*ast.ListItem).Offset; offset < o {
+		o := node.(*ast.ListItem).Offset
+		if state.ordered && ws.Stop > 0 {
+			// The offset includes the width of the number in the source, which
+			// differs from the rendered number if the list has been renumbered.
+			// The marker can only be found if the leading whitespace has been
+			// set, e.g. by the lenient parser.
+			if width, ok := orderedMarkerWidth(source, ws.Stop); ok {
+				o += markerWidth - width
+			}
+		}
+		if offset < o {
 			if _, err := r.Write(w, bytes.Repeat([]byte{' '}, o-offset)); err != nil {
 				return ast.WalkStop, err
 			}
@@ -498,6 +725,19 @@ func (r *Renderer) RenderListItem(w util.BufWriter, source []byte, node ast.Node
 	return ast.WalkContinue, nil
 }
 
+// orderedMarkerWidth returns the width of the ordered list marker at the
+// given position of the source, including a following space.
+func orderedMarkerWidth(source []byte, pos int) (int, bool) {
+	i := pos
+	for i < len(source) && i-pos < 9 && source[i] >= '0' && source[i] <= '9' {
+		i++
+	}
+	if i == pos || i >= len(source) || source[i] != '.' && source[i] != ')' {
+		return 0, false
+	}
+	return i - pos + 2, true
+}
+
 // RenderParagraph renders an *ast.Paragraph node to the given BufWriter.
 func (r *Renderer) RenderParagraph(w util.BufWriter, source []byte, node ast.Node, enter bool) (ast.WalkStatus, error) {
 	if enter {
@@ -660,6 +900,9 @@ func (r *Renderer) RenderCodeSpan(w util.BufWriter, source []byte, node ast.Node
 		if _, err := r.Write(w, delimiter); err != nil {
 			return ast.WalkStop, err
 		}
+		if err := r.WriteAttributes(w, node.Attributes()); err != nil {
+			return ast.WalkStop, err
+		}
 		return ast.WalkSkipChildren, nil
 	}
 
@@ -687,6 +930,9 @@ func (r *Renderer) RenderCodeSpan(w util.BufWriter, source []byte, node ast.Node
 	if _, err := r.Write(w, delimiter); err != nil {
 		return ast.WalkStop, err
 	}
+	if err := r.WriteAttributes(w, node.Attributes()); err != nil {
+		return ast.WalkStop, err
+	}
 
 	return ast.WalkSkipChildren, nil
 }
@@ -697,6 +943,11 @@ func (r *Renderer) RenderEmphasis(w util.BufWriter, source []byte, node ast.Node
 	if _, err := r.WriteString(w, strings.Repeat(string([]byte{em.Marker}), em.Level)); err != nil {
 		return ast.WalkStop, err
 	}
+	if !enter {
+		if err := r.WriteAttributes(w, node.Attributes()); err != nil {
+			return ast.WalkStop, err
+		}
+	}
 	return ast.WalkContinue, nil
 }
 
@@ -784,18 +1035,34 @@ func (r *Renderer) renderLinkOrImage(w util.BufWriter, open string, refType ast.
 // RenderImage renders an *ast.Image node to the given BufWriter.
 func (r *Renderer) RenderImage(w util.BufWriter, source []byte, node ast.Node, enter bool) (ast.WalkStatus, error) {
 	img := node.(*ast.Image)
+	if !enter && img.ExternalReference {
+		r.addExternalReference(img.ReferenceType, img.Label, img.Destination, img.Title)
+	}
 	if err := r.renderLinkOrImage(w, "![", img.ReferenceType, img.Label, img.Destination, img.Title, enter); err != nil {
 		return ast.WalkStop, err
 	}
+	if !enter {
+		if err := r.WriteAttributes(w, node.Attributes()); err != nil {
+			return ast.WalkStop, err
+		}
+	}
 	return ast.WalkContinue, nil
 }
 
 // RenderLink renders an *ast.Link node to the given BufWriter.
 func (r *Renderer) RenderLink(w util.BufWriter, source []byte, node ast.Node, enter bool) (ast.WalkStatus, error) {
 	link := node.(*ast.Link)
+	if !enter && link.ExternalReference {
+		r.addExternalReference(link.ReferenceType, link.Label, link.Destination, link.Title)
+	}
 	if err := r.renderLinkOrImage(w, "[", link.ReferenceType, link.Label, link.Destination, link.Title, enter); err != nil {
 		return ast.WalkStop, err
 	}
+	if !enter {
+		if err := r.WriteAttributes(w, node.Attributes()); err != nil {
+			return ast.WalkStop, err
+		}
+	}
 	return ast.WalkContinue, nil
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/pgavlin/goldmark"
 	"github.com/pgavlin/goldmark/ast"
+	"github.com/pgavlin/goldmark/parser"
 	"github.com/pgavlin/goldmark/renderer"
 	"github.com/pgavlin/goldmark/testutil"
 	"github.com/pgavlin/goldmark/text"
@@ -80,6 +81,79 @@ func TestSpec(t *testing.T) {
 	}
 }
 
+func TestLowercaseDeclarations(t *testing.T) {
+	sourceExpected := []byte("<!doctype html>\n\na <!element b> c\n")
+	parser := goldmark.DefaultParser()
+	expected := parser.Parse(text.NewReader(sourceExpected))
+
+	var buf bytes.Buffer
+	r := renderer.NewRenderer(renderer.WithNodeRenderers(util.Prioritized(&Renderer{}, 100)))
+	if !assert.NoError(t, r.Render(&buf, sourceExpected, expected)) {
+		t.Fatal()
+	}
+	sourceActual := buf.Bytes()
+	assert.Equal(t, string(sourceExpected), string(sourceActual))
+
+	actual := parser.Parse(text.NewReader(sourceActual))
+	testutil.AssertSameStructure(t, sourceExpected, sourceActual, expected, actual, testutil.DefaultNodeAssertions())
+}
+
+func TestExternalReferenceDefinitions(t *testing.T) {
+	resolver := func(label string) (parser.Reference, bool) {
+		if label == "api guide" {
+			return parser.NewReference([]byte("API guide"), []byte("https://example.com/api"), []byte("API")), true
+		}
+		return nil, false
+	}
+
+	source := []byte("See the [API guide] and the [API Guide][].\n")
+	ctx := parser.NewContext(parser.WithReferenceResolver(resolver))
+	doc := goldmark.DefaultParser().Parse(text.NewReader(source), parser.WithContext(ctx))
+
+	cases := []struct {
+		renderer *Renderer
+		expected string
+	}{
+		{&Renderer{}, "See the [API guide] and the [API Guide][].\n"},
+		{NewRenderer(WithExternalReferenceDefinitions()), "See the [API guide] and the [API Guide][].\n\n[API guide]: https://example.com/api \"API\"\n"},
+	}
+	for _, c := range cases {
+		var buf bytes.Buffer
+		r := renderer.NewRenderer(renderer.WithNodeRenderers(util.Prioritized(c.renderer, 100)))
+		if assert.NoError(t, r.Render(&buf, source, doc)) {
+			assert.Equal(t, c.expected, buf.String())
+		}
+	}
+}
+
+func TestLenient(t *testing.T) {
+	sourceExpected := []byte("#Heading\n\nSome text\n2. two\n3) three\n\n10. ten\n  - nested\n  - nested\n11. eleven\n")
+	expected := goldmark.New(goldmark.WithParserOptions(parser.WithLenient())).Parser().Parse(text.NewReader(sourceExpected))
+
+	var buf bytes.Buffer
+	r := renderer.NewRenderer(renderer.WithNodeRenderers(util.Prioritized(&Renderer{}, 100)))
+	if !assert.NoError(t, r.Render(&buf, sourceExpected, expected)) {
+		t.Fatal()
+	}
+	sourceActual := buf.Bytes()
+	assert.Equal(t, "# Heading\n\nSome text\n\n2. two\n3. three\n\n4. ten\n   - nested\n   - nested\n5. eleven\n", string(sourceActual))
+
+	// The rendered document must have the same structure without WithLenient.
+	actual := goldmark.DefaultParser().Parse(text.NewReader(sourceActual))
+	testutil.AssertSameStructure(t, sourceExpected, sourceActual, expected, actual, testutil.DefaultNodeAssertions())
+}
+
+func TestRenumberedList(t *testing.T) {
+	source := []byte("1. one\n10. two\n100.  three\n")
+	doc := goldmark.New(goldmark.WithParserOptions(parser.WithLenient())).Parser().Parse(text.NewReader(source))
+
+	var buf bytes.Buffer
+	r := renderer.NewRenderer(renderer.WithNodeRenderers(util.Prioritized(&Renderer{}, 100)))
+	if assert.NoError(t, r.Render(&buf, source, doc)) {
+		assert.Equal(t, "1. one\n2. two\n3.  three\n", buf.String())
+	}
+}
+
 var caseToRun int
 
 func TestMain(m *testing.M) {
@@ -0,0 +1,239 @@
+package text
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// An Encoding is a character encoding of source text.
+type Encoding int
+
+const (
+	// EncodingUTF8 is UTF-8.
+	EncodingUTF8 Encoding = iota
+
+	// EncodingUTF16LE is little endian UTF-16.
+	EncodingUTF16LE
+
+	// EncodingUTF16BE is big endian UTF-16.
+	EncodingUTF16BE
+
+	// EncodingLatin1 is ISO-8859-1.
+	EncodingLatin1
+)
+
+// String implements fmt.Stringer.
+func (e Encoding) String() string {
+	switch e {
+	case EncodingUTF8:
+		return "UTF-8"
+	case EncodingUTF16LE:
+		return "UTF-16LE"
+	case EncodingUTF16BE:
+		return "UTF-16BE"
+	case EncodingLatin1:
+		return "ISO-8859-1"
+	}
+	return fmt.Sprintf("Encoding(%d)", int(e))
+}
+
+var (
+	bomUTF8    = []byte{0xef, 0xbb, 0xbf}
+	bomUTF16LE = []byte{0xff, 0xfe}
+	bomUTF16BE = []byte{0xfe, 0xff}
+)
+
+// DetectEncoding guesses an encoding of the given source.
+// A byte order mark takes precedence. Without a byte order mark, the source
+// is UTF-16 if it looks like mostly ASCII text encoded in UTF-16, UTF-8 if
+// it is valid UTF-8, and Latin-1 otherwise.
+func DetectEncoding(source []byte) Encoding {
+	switch {
+	case bytes.HasPrefix(source, bomUTF8):
+		return EncodingUTF8
+	case bytes.HasPrefix(source, bomUTF16LE):
+		return EncodingUTF16LE
+	case bytes.HasPrefix(source, bomUTF16BE):
+		return EncodingUTF16BE
+	}
+
+	// ASCII characters encoded in UTF-16 have a zero byte in every
+	// other byte.
+	if len(source) >= 2 && len(source)%2 == 0 {
+		var even, odd int
+		for i := 0; i < len(source); i += 2 {
+			if source[i] == 0 {
+				even++
+			}
+			if source[i+1] == 0 {
+				odd++
+			}
+		}
+		pairs := len(source) / 2
+		if odd*2 > pairs && even*4 < odd {
+			return EncodingUTF16LE
+		}
+		if even*2 > pairs && odd*4 < even {
+			return EncodingUTF16BE
+		}
+	}
+
+	if utf8.Valid(source) {
+		return EncodingUTF8
+	}
+	return EncodingLatin1
+}
+
+// offsetRun is a run of characters that have the same width in the
+// normalized source and in the original source.
+type offsetRun struct {
+	start     int
+	origStart int
+	width     int
+	origWidth int
+	count     int
+}
+
+// An OffsetMap maps offsets in a source normalized by Normalize to offsets
+// in the original source.
+type OffsetMap struct {
+	encoding Encoding
+	length   int
+	origLen  int
+	runs     []offsetRun
+}
+
+// Encoding returns the encoding of the original source.
+func (m *OffsetMap) Encoding() Encoding {
+	return m.encoding
+}
+
+func (m *OffsetMap) add(origStart, width, origWidth, count int) {
+	if l := len(m.runs); l != 0 {
+		last := &m.runs[l-1]
+		if last.width == width && last.origWidth == origWidth &&
+			last.origStart+last.count*last.origWidth == origStart {
+			last.count += count
+			m.length += width * count
+			return
+		}
+	}
+	m.runs = append(m.runs, offsetRun{m.length, origStart, width, origWidth, count})
+	m.length += width * count
+}
+
+// Original returns an offset in the original source that corresponds to
+// the given offset in the normalized source. Offsets in the middle of a
+// character are mapped to the start of the character.
+func (m *OffsetMap) Original(offset int) int {
+	if offset >= m.length {
+		return m.origLen
+	}
+	i := sort.Search(len(m.runs), func(i int) bool {
+		return m.runs[i].start > offset
+	}) - 1
+	if i < 0 {
+		return offset
+	}
+	r := m.runs[i]
+	return r.origStart + (offset-r.start)/r.width*r.origWidth
+}
+
+// OriginalSegment returns a segment of the original source that corresponds
+// to the given segment of the normalized source.
+func (m *OffsetMap) OriginalSegment(segment Segment) Segment {
+	segment.Start = m.Original(segment.Start)
+	segment.Stop = m.Original(segment.Stop)
+	return segment
+}
+
+// Normalize converts the given source to UTF-8 with DetectEncoding and
+// NormalizeEncoding.
+func Normalize(source []byte) ([]byte, *OffsetMap) {
+	return NormalizeEncoding(source, DetectEncoding(source))
+}
+
+// NormalizeEncoding converts the given source in the given encoding to UTF-8
+// text that can be parsed. A byte order mark is removed, and NUL characters
+// and invalid byte sequences are replaced with U+FFFD as CommonMark requires.
+// NormalizeEncoding returns the given source as it is if it is valid UTF-8
+// without byte order marks and NUL characters.
+//
+// The returned OffsetMap maps positions in the normalized source, like
+// segments of AST nodes, to positions in the original source.
+func NormalizeEncoding(source []byte, encoding Encoding) ([]byte, *OffsetMap) {
+	m := &OffsetMap{
+		encoding: encoding,
+		origLen:  len(source),
+	}
+	if encoding == EncodingUTF8 && !bytes.HasPrefix(source, bomUTF8) &&
+		bytes.IndexByte(source, 0) < 0 && utf8.Valid(source) {
+		m.add(0, 1, 1, len(source))
+		return source, m
+	}
+
+	out := make([]byte, 0, len(source)+len(source)/2)
+	i := 0
+	switch encoding {
+	case EncodingUTF8:
+		if bytes.HasPrefix(source, bomUTF8) {
+			i = len(bomUTF8)
+		}
+		for i < len(source) {
+			r, size := utf8.DecodeRune(source[i:])
+			if r == 0 || r == utf8.RuneError && size == 1 {
+				out = utf8.AppendRune(out, utf8.RuneError)
+				m.add(i, utf8.RuneLen(utf8.RuneError), size, 1)
+			} else {
+				out = append(out, source[i:i+size]...)
+				m.add(i, 1, 1, size)
+			}
+			i += size
+		}
+	case EncodingUTF16LE, EncodingUTF16BE:
+		if bytes.HasPrefix(source, bomUTF16LE) || bytes.HasPrefix(source, bomUTF16BE) {
+			i = 2
+		}
+		unit := func(j int) rune {
+			if encoding == EncodingUTF16LE {
+				return rune(source[j]) | rune(source[j+1])<<8
+			}
+			return rune(source[j])<<8 | rune(source[j+1])
+		}
+		for i < len(source) {
+			r, size := utf8.RuneError, len(source)-i
+			if size >= 2 {
+				r, size = unit(i), 2
+				if utf16.IsSurrogate(r) {
+					r = utf8.RuneError
+					if i+4 <= len(source) {
+						if d := utf16.DecodeRune(unit(i), unit(i+2)); d != utf8.RuneError {
+							r, size = d, 4
+						}
+					}
+				}
+			}
+			if r == 0 {
+				r = utf8.RuneError
+			}
+			out = utf8.AppendRune(out, r)
+			m.add(i, utf8.RuneLen(r), size, 1)
+			i += size
+		}
+	case EncodingLatin1:
+		for ; i < len(source); i++ {
+			r := rune(source[i])
+			if r == 0 {
+				r = utf8.RuneError
+			}
+			out = utf8.AppendRune(out, r)
+			m.add(i, utf8.RuneLen(r), 1, 1)
+		}
+	default:
+		panic(fmt.Sprintf("unknown encoding: %v", encoding))
+	}
+	return out, m
+}
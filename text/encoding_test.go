@@ -0,0 +1,70 @@
+package text
+
+import (
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	cases := []struct {
+		name     string
+		source   []byte
+		encoding Encoding
+		expected string
+	}{
+		{"UTF-8", []byte("# héllo\n"), EncodingUTF8, "# héllo\n"},
+		{"UTF-8 BOM", []byte("\xef\xbb\xbf# héllo\n"), EncodingUTF8, "# héllo\n"},
+		{"Latin-1 NUL", []byte("a\x00b\xffc"), EncodingLatin1, "a�bÿc"},
+		{"UTF-16LE BOM", []byte("\xff\xfe#\x00 \x00h\x00\xe9\x00\n\x00"), EncodingUTF16LE, "# hé\n"},
+		{"UTF-16LE", []byte("#\x00 \x00h\x00\n\x00"), EncodingUTF16LE, "# h\n"},
+		{"UTF-16BE", []byte("\x00#\x00 \x00h\x00i\xd8\x3d\xde\x00\x00\n"), EncodingUTF16BE, "# hi😀\n"},
+		{"Latin-1", []byte("caf\xe9 \xa9\n"), EncodingLatin1, "café ©\n"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if encoding := DetectEncoding(c.source); encoding != c.encoding {
+				t.Fatalf("expected %v, but got %v", c.encoding, encoding)
+			}
+			normalized, m := Normalize(c.source)
+			if string(normalized) != c.expected {
+				t.Errorf("expected %q, but got %q", c.expected, normalized)
+			}
+			if m.Original(len(normalized)) != len(c.source) {
+				t.Errorf("expected the end to map to %d, but got %d", len(c.source), m.Original(len(normalized)))
+			}
+		})
+	}
+}
+
+func TestNormalizeInvalidUTF8(t *testing.T) {
+	source := []byte("\xef\xbb\xbfa\x00b\xffc")
+	normalized, m := NormalizeEncoding(source, EncodingUTF8)
+	if string(normalized) != "a�b�c" {
+		t.Fatalf("unexpected normalized source %q", normalized)
+	}
+	expected := []int{3, 4, 4, 4, 5, 6, 6, 6, 7, 8}
+	for i, e := range expected {
+		if o := m.Original(i); o != e {
+			t.Errorf("expected %d to map to %d, but got %d", i, e, o)
+		}
+	}
+}
+
+func TestOffsetMap(t *testing.T) {
+	// "# hé\n" in UTF-16LE with a BOM.
+	source := []byte("\xff\xfe#\x00 \x00h\x00\xe9\x00\n\x00")
+	normalized, m := Normalize(source)
+	if m.Encoding() != EncodingUTF16LE {
+		t.Fatalf("unexpected encoding %v", m.Encoding())
+	}
+	segment := NewSegment(2, 5) // "hé"
+	if string(segment.Value(normalized)) != "hé" {
+		t.Fatalf("unexpected segment value %q", segment.Value(normalized))
+	}
+	if o := m.OriginalSegment(segment); o.Start != 6 || o.Stop != 10 {
+		t.Errorf("expected [6, 10), but got [%d, %d)", o.Start, o.Stop)
+	}
+	// The middle of 'é' maps to its start.
+	if o := m.Original(4); o != 8 {
+		t.Errorf("expected 8, but got %d", o)
+	}
+}
@@ -184,6 +184,19 @@ func (s *Segments) AppendAll(t []Segment) {
 	s.values = append(s.values, t...)
 }
 
+// SetBuffer makes the collection store its elements in the given buffer.
+// The current elements are copied to the buffer. Elements that exceed the
+// capacity of the buffer are stored in a newly allocated slice.
+func (s *Segments) SetBuffer(buffer []Segment) {
+	s.values = append(buffer[:0], s.values...)
+}
+
+// Cap returns the number of elements the collection can hold without
+// allocating.
+func (s *Segments) Cap() int {
+	return cap(s.values)
+}
+
 // Len returns the length of the collection.
 func (s *Segments) Len() int {
 	if s.values == nil {
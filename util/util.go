@@ -522,10 +522,47 @@ func ToValidRune(v rune) rune {
 // ToLinkReference performs unicode case folding, trims leading and trailing spaces,  converts into lower
 // case and replace spaces with a single space character.
 func ToLinkReference(v []byte) string {
-	v = TrimLeftSpace(v)
-	v = TrimRightSpace(v)
-	v = DoFullUnicodeCaseFolding(v)
-	return string(ReplaceSpaces(v, ' '))
+	// Most labels are short, so they are normalized on the stack and only
+	// the resulting string is allocated.
+	var buf [64]byte
+	return string(AppendLinkReference(buf[:0], v))
+}
+
+// AppendLinkReference appends the normalized link label of the given value
+// (see ToLinkReference) to dst and returns the extended buffer.
+func AppendLinkReference(dst, v []byte) []byte {
+	v = TrimRightSpace(TrimLeftSpace(v))
+	space := false
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		if IsSpace(c) {
+			space = true
+			continue
+		}
+		if space {
+			dst = append(dst, ' ')
+			space = false
+		}
+		if c < 0xb5 {
+			if c >= 'A' && c <= 'Z' {
+				c += 32
+			}
+			dst = append(dst, c)
+			continue
+		}
+		if utf8.RuneStart(c) {
+			r, length := utf8.DecodeRune(v[i:])
+			if folded, ok := unicodeCaseFoldings[r]; ok && r != utf8.RuneError {
+				for _, f := range folded {
+					dst = utf8.AppendRune(dst, f)
+				}
+				i += length - 1
+				continue
+			}
+		}
+		dst = append(dst, c)
+	}
+	return dst
 }
 
 var htmlQuote = []byte("&quot;")
@@ -821,7 +858,9 @@ func IsPunct(c byte) bool {
 	return punctTable[c] == 1
 }
 
-// IsPunctRune returns true if the given rune is a punctuation, otherwise false.
+// IsPunctRune returns true if the given rune is a Unicode punctuation character
+// (a character in the general Unicode categories P or S), otherwise false.
+// See https://spec.commonmark.org/0.31.2/#unicode-punctuation-character for details.
 func IsPunctRune(r rune) bool {
 	return unicode.IsSymbol(r) || unicode.IsPunct(r)
 }